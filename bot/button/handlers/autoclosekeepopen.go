@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/worker/bot/button/registry"
+	"github.com/TicketsBot-cloud/worker/bot/button/registry/matcher"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/context"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type AutoCloseKeepOpenHandler struct{}
+
+func (h *AutoCloseKeepOpenHandler) Matcher() matcher.Matcher {
+	return &matcher.SimpleMatcher{
+		CustomId: "autoclose_keep_open",
+	}
+}
+
+func (h *AutoCloseKeepOpenHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:   registry.SumFlags(registry.GuildAllowed),
+		Timeout: time.Second * 3,
+	}
+}
+
+func (h *AutoCloseKeepOpenHandler) Execute(ctx *context.ButtonContext) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ticket.Id == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	// Check if user is ticket opener or has staff permission
+	isOpener := ctx.UserId() == ticket.UserId
+	hasStaffPermission, err := logic.HasPermissionForTicket(ctx, ctx.Worker(), ticket, ctx.UserId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !isOpener && !hasStaffPermission {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageAutoCloseKeepOpenNoPermission)
+		return
+	}
+
+	if _, err := logic.CancelAutoClose(ctx, ctx.GuildId(), ticket.Id); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Reset the inactivity timer, so that the ticket is not warned again straight away
+	if err := dbclient.Client.TicketLastMessage.Set(ctx, ctx.GuildId(), ticket.Id, ctx.Interaction.Message.Id, ctx.UserId(), !isOpener); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Edit(command.MessageResponse{
+		Embeds: utils.Embeds(utils.BuildEmbed(ctx, customisation.Green, i18n.TitleAutoclose, i18n.MessageAutoCloseKeptOpen, nil, ctx.UserId())),
+	})
+}
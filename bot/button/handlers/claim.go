@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/TicketsBot-cloud/common/permission"
@@ -56,6 +57,12 @@ func (h *ClaimHandler) Execute(ctx *context.ButtonContext) {
 	}
 
 	if err := logic.ClaimTicket(ctx.Context, ctx, ticket, ctx.UserId()); err != nil {
+		var limitErr *logic.ClaimLimitError
+		if errors.As(err, &limitErr) {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageClaimLimitReached, limitErr.Limit)
+			return
+		}
+
 		ctx.HandleError(err)
 		return
 	}
@@ -137,8 +137,7 @@ func (h *ExitSurveySubmitHandler) Execute(cmd *cmdcontext.ModalContext) {
 
 	formAnswers := parseModalComponents(cmd.Interaction.Data.Components, inputsByCustomId)
 
-	if invalidLabel, valid := validateFormAnswers(formAnswers); !valid {
-		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageFormMissingInput, invalidLabel)
+	if !validateFormAnswers(cmd, guildId, formAnswers) {
 		return
 	}
 
@@ -15,6 +15,8 @@ import (
 	"github.com/TicketsBot-cloud/worker/bot/customisation"
 	"github.com/TicketsBot-cloud/worker/bot/dbclient"
 	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
 	"github.com/TicketsBot-cloud/worker/i18n"
 )
 
@@ -69,16 +71,103 @@ func (h *FormHandler) Execute(ctx *context.ModalContext) {
 
 		formAnswers := parseModalComponents(data.Components, inputs)
 
-		if invalidLabel, valid := validateFormAnswers(formAnswers); !valid {
-			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormMissingInput, invalidLabel)
+		if !validateFormAnswers(ctx, ctx.GuildId(), formAnswers) {
 			return
 		}
 
+		if panel.FormId == nil {
+			ctx.Defer()
+			_, _ = logic.OpenTicket(ctx.Context, ctx, &panel, panel.Title, formAnswers, nil, outOfHoursTitle, outOfHoursWarning, outOfHoursColour)
+			return
+		}
+
+		// This is the first step of the form, so any form the user had previously started is discarded
+		session := redis.FormSession{PanelId: panel.PanelId}
+		advanceForm(ctx, panel, *panel.FormId, session, formAnswers, inputs, outOfHoursTitle, outOfHoursWarning, outOfHoursColour)
+
+		return
+	}
+}
+
+// advanceForm records the answers to a step of the panel's form. If the form branches on to another step, the user is
+// given a button to continue to it, otherwise the ticket is opened with the answers to every step.
+func advanceForm(
+	ctx *context.ModalContext,
+	panel database.Panel,
+	formId int,
+	session redis.FormSession,
+	answers map[database.FormInput]string,
+	inputs map[string]database.FormInput,
+	outOfHoursTitle, outOfHoursWarning *string,
+	outOfHoursColour *int,
+) {
+	flow, hasFlow, err := dbclient.Storage.FormFlows.Get(ctx, ctx.GuildId(), formId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Single step forms don't need a session
+	if !hasFlow && len(session.FormIds) == 0 {
 		ctx.Defer()
-		_, _ = logic.OpenTicket(ctx.Context, ctx, &panel, panel.Title, formAnswers, outOfHoursTitle, outOfHoursWarning, outOfHoursColour)
+		_, _ = logic.OpenTicket(ctx.Context, ctx, &panel, panel.Title, answers, nil, outOfHoursTitle, outOfHoursWarning, outOfHoursColour)
+		return
+	}
+
+	session.FormIds = append(session.FormIds, formId)
+	if session.Answers == nil {
+		session.Answers = make(map[int]string)
+	}
+
+	for input, answer := range answers {
+		session.Answers[input.Id] = answer
+	}
+
+	if hasFlow && len(session.FormIds) < logic.MaxFormSteps {
+		nextFormId := logic.NextFormStep(flow, session.Answers)
+
+		// Forms can't be shown twice, as the answers to the first showing would be overwritten
+		if nextFormId != 0 && !utils.Contains(session.FormIds, nextFormId) {
+			ok, err := logic.IsValidFormStep(ctx, ctx.GuildId(), nextFormId)
+			if err != nil {
+				ctx.HandleError(err)
+				return
+			}
+
+			if ok {
+				session.NextFormId = nextFormId
+				if err := redis.SetFormSession(ctx, ctx.GuildId(), ctx.UserId(), session); err != nil {
+					ctx.HandleError(err)
+					return
+				}
+
+				continueButton := component.BuildButton(component.Button{
+					Label:    ctx.GetMessage(i18n.MessageFormStepContinueButton),
+					CustomId: fmt.Sprintf("formcontinue_%d_%s", nextFormId, panel.CustomId),
+					Style:    component.ButtonStylePrimary,
+				})
+
+				e := utils.BuildEmbed(ctx, customisation.Green, i18n.TitleFormFlow, i18n.MessageFormStepContinue, nil, len(session.FormIds))
+				ctx.ReplyWithEmbedAndComponents(e, utils.Slice(component.BuildActionRow(continueButton)))
+				return
+			}
+		}
+	}
 
+	if err := redis.DeleteFormSession(ctx, ctx.GuildId(), ctx.UserId()); err != nil {
+		ctx.HandleError(err)
 		return
 	}
+
+	combinedAnswers := make(map[database.FormInput]string)
+	for _, input := range inputs {
+		if answer, ok := session.Answers[input.Id]; ok {
+			combinedAnswers[input] = answer
+		}
+	}
+
+	ctx.Defer()
+	_, _ = logic.OpenTicket(ctx.Context, ctx, &panel, panel.Title, combinedAnswers, session.FormIds, outOfHoursTitle, outOfHoursWarning, outOfHoursColour)
 }
 
 // parseModalComponents extracts answers from modal action rows, keyed by the matching FormInput.
@@ -118,26 +207,26 @@ func parseModalComponents(actionRows []interaction.ModalSubmitInteractionActionR
 	return answers
 }
 
-// validateFormAnswers checks that all required fields have a non-whitespace value.
-// Returns the label of the first invalid field and false if validation fails.
-func validateFormAnswers(answers map[database.FormInput]string) (string, bool) {
-	for question, answer := range answers {
-		if !question.Required {
-			continue
-		}
-		// Check that users have not just pressed newline or space
-		valid := false
-		for _, c := range answer {
-			if c != ' ' && c != '\n' {
-				valid = true
-				break
-			}
-		}
-		if !valid {
-			return question.Label, false
-		}
+// validateFormAnswers checks the answers against the required flags and the guild's validation rules, replying with
+// every invalid answer. Returns false if any answer was invalid.
+func validateFormAnswers(ctx *context.ModalContext, guildId uint64, answers map[database.FormInput]string) bool {
+	validationErrs, err := logic.ValidateFormAnswers(ctx, guildId, answers)
+	if err != nil {
+		ctx.HandleError(err)
+		return false
 	}
-	return "", true
+
+	if len(validationErrs) == 0 {
+		return true
+	}
+
+	lines := make([]string, len(validationErrs))
+	for i, validationErr := range validationErrs {
+		lines[i] = fmt.Sprintf("**%s**: %s", validationErr.Input.Label, validationErr.Reason(ctx))
+	}
+
+	ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormValidationFailed, strings.Join(lines, "\n"))
+	return false
 }
 
 func joinMentions(mentions []string, mentionType string) string {
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/button"
+	"github.com/TicketsBot-cloud/worker/bot/button/registry"
+	"github.com/TicketsBot-cloud/worker/bot/button/registry/matcher"
+	"github.com/TicketsBot-cloud/worker/bot/command/context"
+	cmdregistry "github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type FormContinueHandler struct{}
+
+func (h *FormContinueHandler) Matcher() matcher.Matcher {
+	return matcher.NewFuncMatcher(func(customId string) bool {
+		return strings.HasPrefix(customId, "formcontinue_")
+	})
+}
+
+func (h *FormContinueHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:   registry.SumFlags(registry.GuildAllowed),
+		Timeout: time.Second * 3,
+	}
+}
+
+func (h *FormContinueHandler) Execute(ctx *context.ButtonContext) {
+	formId, panelCustomId, ok := parseFormStepCustomId(ctx.InteractionData.CustomId, "formcontinue_")
+	if !ok {
+		return
+	}
+
+	panel, _, ok, err := getFormStepSession(ctx, panelCustomId, formId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormStepExpired)
+		return
+	}
+
+	form, ok, err := dbclient.Client.Forms.Get(ctx, formId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok || form.GuildId != ctx.GuildId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormStepExpired)
+		return
+	}
+
+	inputs, err := dbclient.Client.FormInput.GetInputs(ctx, form.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	inputOptions, err := dbclient.Client.FormInputOption.GetOptionsByForm(ctx, form.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Modal(button.ResponseModal{
+		Data: interaction.ModalResponseData{
+			CustomId:   fmt.Sprintf("formstep_%d_%s", form.Id, panel.CustomId),
+			Title:      form.Title,
+			Components: buildFormComponents(inputs, inputOptions),
+		},
+	})
+}
+
+// parseFormStepCustomId splits a custom ID of the form `prefix<form id>_<panel custom id>`
+func parseFormStepCustomId(customId, prefix string) (int, string, bool) {
+	split := strings.SplitN(strings.TrimPrefix(customId, prefix), "_", 2)
+	if len(split) != 2 {
+		return 0, "", false
+	}
+
+	formId, err := strconv.Atoi(split[0])
+	if err != nil {
+		return 0, "", false
+	}
+
+	return formId, split[1], true
+}
+
+// getFormStepSession returns the user's partial answers to the panel's form, or false if they have expired or the
+// user has not reached the step for the given form
+func getFormStepSession(ctx cmdregistry.InteractionContext, panelCustomId string, formId int) (database.Panel, redis.FormSession, bool, error) {
+	panel, ok, err := dbclient.Client.Panel.GetByCustomId(ctx, ctx.GuildId(), panelCustomId)
+	if err != nil || !ok || panel.GuildId != ctx.GuildId() {
+		return database.Panel{}, redis.FormSession{}, false, err
+	}
+
+	session, ok, err := redis.GetFormSession(ctx, ctx.GuildId(), ctx.UserId())
+	if err != nil || !ok {
+		return database.Panel{}, redis.FormSession{}, false, err
+	}
+
+	if session.PanelId != panel.PanelId || session.NextFormId != formId {
+		return database.Panel{}, redis.FormSession{}, false, nil
+	}
+
+	return panel, session, true, nil
+}
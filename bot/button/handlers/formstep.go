@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/TicketsBot-cloud/worker/bot/button/registry"
+	"github.com/TicketsBot-cloud/worker/bot/button/registry/matcher"
+	"github.com/TicketsBot-cloud/worker/bot/command/context"
+	"github.com/TicketsBot-cloud/worker/bot/constants"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+// FormStepHandler handles the submission of every step of a multi-step form after the first, which is handled by
+// FormHandler
+type FormStepHandler struct{}
+
+func (h *FormStepHandler) Matcher() matcher.Matcher {
+	return matcher.NewFuncMatcher(func(customId string) bool {
+		return strings.HasPrefix(customId, "formstep_")
+	})
+}
+
+func (h *FormStepHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:   registry.SumFlags(registry.GuildAllowed),
+		Timeout: constants.TimeoutOpenTicket,
+	}
+}
+
+func (h *FormStepHandler) Execute(ctx *context.ModalContext) {
+	formId, panelCustomId, ok := parseFormStepCustomId(ctx.Interaction.Data.CustomId, "formstep_")
+	if !ok {
+		return
+	}
+
+	panel, session, ok, err := getFormStepSession(ctx, panelCustomId, formId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormStepExpired)
+		return
+	}
+
+	canProceed, outOfHoursTitle, outOfHoursWarning, outOfHoursColour, err := logic.ValidatePanelAccess(ctx, panel)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !canProceed {
+		return
+	}
+
+	inputs, err := dbclient.Client.FormInput.GetAllInputsByCustomId(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	formAnswers := parseModalComponents(ctx.Interaction.Data.Components, inputs)
+
+	if !validateFormAnswers(ctx, ctx.GuildId(), formAnswers) {
+		return
+	}
+
+	advanceForm(ctx, panel, formId, session, formAnswers, inputs, outOfHoursTitle, outOfHoursWarning, outOfHoursColour)
+}
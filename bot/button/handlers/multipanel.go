@@ -58,7 +58,7 @@ func (h *MultiPanelHandler) Execute(ctx *context.SelectMenuContext) {
 		}
 
 		if panel.FormId == nil {
-			_, _ = logic.OpenTicket(ctx.Context, ctx, &panel, panel.Title, nil, outOfHoursTitle, outOfHoursWarning, outOfHoursColour)
+			_, _ = logic.OpenTicket(ctx.Context, ctx, &panel, panel.Title, nil, nil, outOfHoursTitle, outOfHoursWarning, outOfHoursColour)
 		} else {
 			form, ok, err := dbclient.Client.Forms.Get(ctx, *panel.FormId)
 			if err != nil {
@@ -84,7 +84,7 @@ func (h *MultiPanelHandler) Execute(ctx *context.SelectMenuContext) {
 			}
 
 			if len(inputs) == 0 { // Don't open a blank form
-				_, _ = logic.OpenTicket(ctx.Context, ctx, &panel, panel.Title, nil, outOfHoursTitle, outOfHoursWarning, outOfHoursColour)
+				_, _ = logic.OpenTicket(ctx.Context, ctx, &panel, panel.Title, nil, nil, outOfHoursTitle, outOfHoursWarning, outOfHoursColour)
 			} else {
 				modal := buildForm(panel, form, inputs, inputOptions)
 				ctx.Modal(modal)
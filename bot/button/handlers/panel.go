@@ -56,7 +56,7 @@ func (h *PanelHandler) Execute(ctx *context.ButtonContext) {
 		}
 
 		if panel.FormId == nil {
-			_, _ = logic.OpenTicket(ctx.Context, ctx, &panel, panel.Title, nil, outOfHoursTitle, outOfHoursWarning, outOfHoursColour)
+			_, _ = logic.OpenTicket(ctx.Context, ctx, &panel, panel.Title, nil, nil, outOfHoursTitle, outOfHoursWarning, outOfHoursColour)
 		} else {
 			form, ok, err := dbclient.Client.Forms.Get(ctx, *panel.FormId)
 			if err != nil {
@@ -82,7 +82,7 @@ func (h *PanelHandler) Execute(ctx *context.ButtonContext) {
 			}
 
 			if len(inputs) == 0 { // Don't open a blank form
-				_, _ = logic.OpenTicket(ctx.Context, ctx, &panel, panel.Title, nil, outOfHoursTitle, outOfHoursWarning, outOfHoursColour)
+				_, _ = logic.OpenTicket(ctx.Context, ctx, &panel, panel.Title, nil, nil, outOfHoursTitle, outOfHoursWarning, outOfHoursColour)
 			} else {
 				modal := buildForm(panel, form, inputs, inputOptions)
 				ctx.Modal(modal)
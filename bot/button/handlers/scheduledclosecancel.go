@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/worker/bot/button/registry"
+	"github.com/TicketsBot-cloud/worker/bot/button/registry/matcher"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/context"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type ScheduledCloseCancelHandler struct{}
+
+func (h *ScheduledCloseCancelHandler) Matcher() matcher.Matcher {
+	return &matcher.SimpleMatcher{
+		CustomId: "scheduled_close_cancel",
+	}
+}
+
+func (h *ScheduledCloseCancelHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:   registry.SumFlags(registry.GuildAllowed),
+		Timeout: time.Second * 3,
+	}
+}
+
+func (h *ScheduledCloseCancelHandler) Execute(ctx *context.ButtonContext) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ticket.Id == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	// Check if user is ticket opener or has staff permission
+	isOpener := ctx.UserId() == ticket.UserId
+	hasStaffPermission, err := logic.HasPermissionForTicket(ctx, ctx.Worker(), ticket, ctx.UserId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !isOpener && !hasStaffPermission {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageScheduledCloseNoPermission)
+		return
+	}
+
+	if _, err := logic.CancelScheduledClose(ctx, ctx.GuildId(), ticket.Id); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Edit(command.MessageResponse{
+		Embeds: utils.Embeds(utils.BuildEmbed(ctx, customisation.Green, i18n.TitleClose, i18n.MessageScheduledCloseCancelled, nil, ctx.UserId())),
+	})
+}
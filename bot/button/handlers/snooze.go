@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction/component"
+	"github.com/TicketsBot-cloud/worker/bot/button"
+	"github.com/TicketsBot-cloud/worker/bot/button/registry"
+	"github.com/TicketsBot-cloud/worker/bot/button/registry/matcher"
+	"github.com/TicketsBot-cloud/worker/bot/command/context"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type SnoozeHandler struct{}
+
+func (h *SnoozeHandler) Matcher() matcher.Matcher {
+	return &matcher.SimpleMatcher{
+		CustomId: "snooze",
+	}
+}
+
+func (h *SnoozeHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:   registry.SumFlags(registry.GuildAllowed),
+		Timeout: time.Second * 3,
+	}
+}
+
+func (h *SnoozeHandler) Execute(ctx *context.ButtonContext) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ticket.Id == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	hasPermission, err := logic.HasPermissionForTicket(ctx, ctx.Worker(), ticket, ctx.UserId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !hasPermission {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageSnoozeNoPermission)
+		return
+	}
+
+	ctx.Modal(button.ResponseModal{
+		Data: interaction.ModalResponseData{
+			CustomId: "snooze_submit",
+			Title:    i18n.TitleSnooze.GetFromGuild(ctx.GuildId()),
+			Components: []component.Component{
+				component.BuildLabel(component.Label{
+					Label:       i18n.MessageSnoozeModalLabel.GetFromGuild(ctx.GuildId()),
+					Description: utils.Ptr(i18n.MessageSnoozeModalHint.GetFromGuild(ctx.GuildId())),
+					Component: component.BuildInputText(component.InputText{
+						Style:       component.TextStyleShort,
+						CustomId:    "until",
+						Placeholder: utils.Ptr("3d"),
+						Required:    utils.Ptr(true),
+						MaxLength:   utils.Ptr(uint32(32)),
+					}),
+				}),
+			},
+		},
+	})
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/button/registry"
+	"github.com/TicketsBot-cloud/worker/bot/button/registry/matcher"
+	"github.com/TicketsBot-cloud/worker/bot/command/context"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type SnoozeSubmitHandler struct{}
+
+func (h *SnoozeSubmitHandler) Matcher() matcher.Matcher {
+	return matcher.NewSimpleMatcher("snooze_submit")
+}
+
+func (h *SnoozeSubmitHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:   registry.SumFlags(registry.GuildAllowed),
+		Timeout: time.Second * 10,
+	}
+}
+
+func (h *SnoozeSubmitHandler) Execute(ctx *context.ModalContext) {
+	data := ctx.Interaction.Data
+
+	if len(data.Components) == 0 { // No action rows
+		ctx.HandleError(fmt.Errorf("No action rows found in modal components"))
+		return
+	}
+
+	actionRow := data.Components[0]
+	if len(actionRow.Components) == 0 && actionRow.Component == nil { // Text input missing
+		ctx.HandleError(fmt.Errorf("Modal missing text input"))
+		return
+	}
+
+	var textInput interaction.ModalSubmitInteractionComponentData
+
+	if actionRow.Component != nil {
+		textInput = *actionRow.Component
+	} else {
+		textInput = actionRow.Components[0]
+	}
+
+	if textInput.CustomId != "until" {
+		ctx.HandleError(fmt.Errorf("Text input custom ID mismatch"))
+		return
+	}
+
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ticket.Id == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	logic.SnoozeTicketWithReply(ctx, ctx, ticket, textInput.Value)
+}
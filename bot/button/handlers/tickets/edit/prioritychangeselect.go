@@ -0,0 +1,60 @@
+package edit
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/worker/bot/button/registry"
+	"github.com/TicketsBot-cloud/worker/bot/button/registry/matcher"
+	"github.com/TicketsBot-cloud/worker/bot/command/context"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type PriorityChangeSelectHandler struct{}
+
+func (h *PriorityChangeSelectHandler) Matcher() matcher.Matcher {
+	return &matcher.SimpleMatcher{
+		CustomId: "update-ticket-priority-select",
+	}
+}
+
+func (h *PriorityChangeSelectHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:           registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		PermissionLevel: permission.Support,
+		Timeout:         time.Second * 8,
+	}
+}
+
+func (h *PriorityChangeSelectHandler) Execute(ctx *context.SelectMenuContext) {
+	if len(ctx.InteractionData.Values) == 0 {
+		return
+	}
+
+	priority, ok := logic.ParsePriority(ctx.InteractionData.Values[0])
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePriorityInvalid)
+		return
+	}
+
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ticket.Id == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	if err := logic.ChangeTicketPriority(ctx, ctx, ticket, priority); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.ReplyPermanent(customisation.Green, i18n.TitlePriority, i18n.MessagePrioritySuccess, ctx.UserId(), logic.PriorityNames[priority])
+}
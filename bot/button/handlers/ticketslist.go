@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction/component"
+	"github.com/TicketsBot-cloud/worker/bot/button/registry"
+	"github.com/TicketsBot-cloud/worker/bot/button/registry/matcher"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/context"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type TicketsListHandler struct{}
+
+func (h *TicketsListHandler) Matcher() matcher.Matcher {
+	return &matcher.FuncMatcher{
+		Func: logic.IsTicketListCustomId,
+	}
+}
+
+func (h *TicketsListHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:   registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		Timeout: time.Second * 10,
+	}
+}
+
+func (h *TicketsListHandler) Execute(ctx *context.ButtonContext) {
+	filter, page, ok := logic.ParseTicketListCustomId(ctx.InteractionData.CustomId)
+	if !ok {
+		return
+	}
+
+	permissionLevel, err := ctx.UserPermissionLevel(ctx)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if permissionLevel < permission.Support {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNoPermission)
+		return
+	}
+
+	comp, adjustedPage, totalPages, err := logic.BuildTicketListMessage(ctx.Context, ctx, filter, page)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Edit(command.MessageResponse{
+		Components: []component.Component{
+			comp,
+			logic.BuildTicketListButtons(filter, adjustedPage, totalPages),
+		},
+	})
+}
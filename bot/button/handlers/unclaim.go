@@ -112,7 +112,7 @@ func (h *UnclaimHandler) Execute(ctx *context.ButtonContext) {
 	}
 
 	// Restore original permissions
-	overwrites, err := logic.CreateOverwrites(ctx.Context, ctx, ticket.UserId, panel, ch.ParentId.Value)
+	overwrites, err := logic.CreateTicketOverwrites(ctx.Context, ctx, ticket, panel, ch.ParentId.Value)
 	if err != nil {
 		ctx.HandleError(err)
 		return
@@ -54,6 +54,7 @@ func (m *ComponentInteractionManager) RegisterCommands() {
 	m.buttonRegistry = append(m.buttonRegistry,
 		new(handlers.AddAdminHandler),
 		new(handlers.AddSupportHandler),
+		new(handlers.AutoCloseKeepOpenHandler),
 		new(handlers.CloseHandler),
 		new(handlers.CloseWithReasonModalHandler),
 		new(handlers.EditCloseReasonModalHandler),
@@ -62,6 +63,7 @@ func (m *ComponentInteractionManager) RegisterCommands() {
 		new(handlers.CloseConfirmHandler),
 		new(handlers.CloseRequestAcceptHandler),
 		new(handlers.CloseRequestDenyHandler),
+		new(handlers.FormContinueHandler),
 		new(handlers.GDPRAllTranscriptsHandler),
 		new(handlers.GDPRSpecificTranscriptsHandler),
 		new(handlers.GDPRAllMessagesHandler),
@@ -77,6 +79,9 @@ func (m *ComponentInteractionManager) RegisterCommands() {
 		new(handlers.PremiumKeyButtonHandler),
 		new(handlers.RateHandler),
 		new(handlers.RedeemVoteCreditsHandler),
+		new(handlers.ScheduledCloseCancelHandler),
+		new(handlers.SnoozeHandler),
+		new(handlers.TicketsListHandler),
 		new(handlers.ViewStaffHandler),
 		new(handlers.ViewSurveyHandler),
 		new(server.AdminDebugServerRecacheHandler),
@@ -94,10 +99,12 @@ func (m *ComponentInteractionManager) RegisterCommands() {
 		new(handlers.LanguageSelectorHandler),
 		new(handlers.MultiPanelHandler),
 		new(handlers.PremiumKeyOpenHandler),
+		new(edit.PriorityChangeSelectHandler),
 	)
 
 	m.modalRegistry = append(m.modalRegistry,
 		new(handlers.FormHandler),
+		new(handlers.FormStepHandler),
 		new(handlers.CloseWithReasonSubmitHandler),
 		new(handlers.EditCloseReasonSubmitHandler),
 		new(handlers.ExitSurveySubmitHandler),
@@ -106,6 +113,7 @@ func (m *ComponentInteractionManager) RegisterCommands() {
 		new(handlers.GDPRModalAllMessagesHandler),
 		new(handlers.GDPRModalSpecificMessagesHandler),
 		new(handlers.PremiumKeySubmitHandler),
+		new(handlers.SnoozeSubmitHandler),
 		new(edit.LabelChangeSubmitHandler),
 		new(modals.AdminDebugServerPanelSettingsModalHandler),
 		new(modals.AdminDebugServerPermissionsModalSubmitHandler),
@@ -0,0 +1,58 @@
+package settings
+
+import (
+	"strings"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type AutoAssignCommand struct {
+}
+
+func (AutoAssignCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "autoassign",
+		Description:     i18n.HelpAutoAssign,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Children: []registry.Command{
+			AutoAssignSetCommand{},
+			AutoAssignDisableCommand{},
+		},
+	}
+}
+
+func (c AutoAssignCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (AutoAssignCommand) Execute(ctx registry.CommandContext) {
+	// Can't call a parent command
+}
+
+var autoAssignStrategyNames = map[storage.AutoAssignStrategy]string{
+	storage.AutoAssignRoundRobin:  "Round robin",
+	storage.AutoAssignLeastLoaded: "Fewest open claims",
+	storage.AutoAssignRandom:      "Random",
+}
+
+func autoAssignStrategyAutoCompleteHandler(_ interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	var choices []interaction.ApplicationCommandOptionChoice
+	for _, strategy := range storage.AutoAssignStrategies {
+		name := autoAssignStrategyNames[strategy]
+		if value == "" || strings.Contains(strings.ToLower(name), strings.ToLower(value)) {
+			choices = append(choices, interaction.ApplicationCommandOptionChoice{
+				Name:  name,
+				Value: string(strategy),
+			})
+		}
+	}
+
+	return choices
+}
@@ -0,0 +1,72 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type AutoAssignDisableCommand struct {
+}
+
+func (AutoAssignDisableCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "disable",
+		Description:     i18n.HelpAutoAssignDisable,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("panel", "Panel to stop automatically assigning tickets from", interaction.OptionTypeInteger, i18n.MessageSwitchPanelInvalidPanel, panelAutoCompleteHandler),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c AutoAssignDisableCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (AutoAssignDisableCommand) Execute(ctx registry.CommandContext, panelId int) {
+	panel, err := dbclient.Client.Panel.GetById(ctx, panelId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if panel.PanelId == 0 || panel.GuildId != ctx.GuildId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageSwitchPanelInvalidPanel)
+		return
+	}
+
+	_, ok, err := dbclient.Storage.AutoAssignPolicies.Get(ctx, ctx.GuildId(), panel.PanelId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageAutoAssignDisableNotEnabled, panel.Title)
+		return
+	}
+
+	if err := dbclient.Storage.AutoAssignPolicies.Delete(ctx, ctx.GuildId(), panel.PanelId); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if err := redis.ResetAutoAssignTurn(ctx, ctx.GuildId(), panel.PanelId); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleAutoAssign, i18n.MessageAutoAssignDisableSuccess, panel.Title)
+}
@@ -0,0 +1,71 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type AutoAssignSetCommand struct {
+}
+
+func (AutoAssignSetCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "set",
+		Description:     i18n.HelpAutoAssignSet,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("panel", "Panel to automatically assign tickets from", interaction.OptionTypeInteger, i18n.MessageSwitchPanelInvalidPanel, panelAutoCompleteHandler),
+			command.NewRequiredAutocompleteableArgument("strategy", "How to choose the staff member to assign", interaction.OptionTypeString, i18n.MessageAutoAssignInvalidStrategy, autoAssignStrategyAutoCompleteHandler),
+			command.NewOptionalArgument("max_open_claims", "Skip staff who already have this many open claimed tickets", interaction.OptionTypeInteger, i18n.MessageInvalidArgument),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c AutoAssignSetCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (AutoAssignSetCommand) Execute(ctx registry.CommandContext, panelId int, strategy string, maxOpenClaims *int) {
+	panel, err := dbclient.Client.Panel.GetById(ctx, panelId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if panel.PanelId == 0 || panel.GuildId != ctx.GuildId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageSwitchPanelInvalidPanel)
+		return
+	}
+
+	policy := storage.AutoAssignPolicy{
+		Strategy: storage.AutoAssignStrategy(strategy),
+	}
+
+	if !policy.Strategy.IsValid() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageAutoAssignInvalidStrategy)
+		return
+	}
+
+	if maxOpenClaims != nil && *maxOpenClaims > 0 {
+		policy.MaxOpenClaims = *maxOpenClaims
+	}
+
+	if err := dbclient.Storage.AutoAssignPolicies.Set(ctx, ctx.GuildId(), panel.PanelId, policy); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleAutoAssign, i18n.MessageAutoAssignSetSuccess, panel.Title, autoAssignStrategyNames[policy.Strategy])
+}
@@ -21,6 +21,7 @@ func (AutoCloseCommand) Properties() registry.Properties {
 		Children: []registry.Command{
 			AutoCloseConfigureCommand{},
 			AutoCloseExcludeCommand{},
+			AutoCloseWarningCommand{},
 		},
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/TicketsBot-cloud/worker/bot/command/registry"
 	"github.com/TicketsBot-cloud/worker/bot/customisation"
 	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
 	"github.com/TicketsBot-cloud/worker/i18n"
 )
 
@@ -48,5 +49,11 @@ func (AutoCloseExcludeCommand) Execute(ctx registry.CommandContext) {
 		return
 	}
 
+	// Don't close a ticket that has already been warned
+	if _, err := logic.CancelAutoClose(ctx, ctx.GuildId(), ticket.Id); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
 	ctx.Reply(customisation.Green, i18n.TitleAutoclose, i18n.MessageAutoCloseExclude)
 }
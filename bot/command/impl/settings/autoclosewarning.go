@@ -0,0 +1,66 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type AutoCloseWarningCommand struct {
+}
+
+func (AutoCloseWarningCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "warning",
+		Description:     i18n.HelpAutoCloseWarning,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("period", "Minutes between the warning and the ticket being closed, or 0 to close without warning", interaction.OptionTypeInteger, i18n.MessageAutoCloseWarningInvalidPeriod),
+			command.NewOptionalArgument("dm", "Whether the ticket opener should also be warned by DM", interaction.OptionTypeBoolean, i18n.MessageInvalidArgument),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c AutoCloseWarningCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (AutoCloseWarningCommand) Execute(ctx registry.CommandContext, period int, dm *bool) {
+	if period < 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageAutoCloseWarningInvalidPeriod)
+		return
+	}
+
+	if period == 0 {
+		if _, err := dbclient.Storage.AutoCloseWarnings.Delete(ctx, ctx.GuildId()); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleAutoclose, i18n.MessageAutoCloseWarningDisabled)
+		return
+	}
+
+	warning := storage.AutoCloseWarning{
+		Period: time.Duration(period) * time.Minute,
+		Dm:     dm != nil && *dm,
+	}
+
+	if err := dbclient.Storage.AutoCloseWarnings.Set(ctx, ctx.GuildId(), warning); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleAutoclose, i18n.MessageAutoCloseWarningSet, period)
+}
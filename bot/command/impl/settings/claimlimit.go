@@ -0,0 +1,86 @@
+package settings
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/common/sentry"
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type ClaimLimitCommand struct {
+}
+
+func (ClaimLimitCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "claimlimit",
+		Description:     i18n.HelpClaimLimit,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Children: []registry.Command{
+			ClaimLimitSetCommand{},
+			ClaimLimitRemoveCommand{},
+		},
+	}
+}
+
+func (c ClaimLimitCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (ClaimLimitCommand) Execute(ctx registry.CommandContext) {
+	// Can't call a parent command
+}
+
+func supportTeamAutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	if data.GuildId.Value == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
+	defer cancel()
+
+	teams, err := dbclient.Client.SupportTeam.Get(ctx, data.GuildId.Value)
+	if err != nil {
+		sentry.Error(err) // TODO: Context
+		return nil
+	}
+
+	choices := make([]interaction.ApplicationCommandOptionChoice, 0, 25)
+	for _, team := range teams {
+		if value == "" || strings.Contains(strings.ToLower(team.Name), strings.ToLower(value)) {
+			choices = append(choices, interaction.ApplicationCommandOptionChoice{
+				Name:  team.Name,
+				Value: team.Id,
+			})
+		}
+
+		if len(choices) == 25 {
+			break
+		}
+	}
+
+	return choices
+}
+
+// getSupportTeam returns false if teamId is not nil and does not belong to a team in the guild
+func getSupportTeam(ctx registry.CommandContext, teamId *int) (*database.SupportTeam, bool, error) {
+	if teamId == nil {
+		return nil, true, nil
+	}
+
+	team, ok, err := dbclient.Client.SupportTeam.GetById(ctx, ctx.GuildId(), *teamId)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	return &team, true, nil
+}
@@ -0,0 +1,65 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type ClaimLimitRemoveCommand struct {
+}
+
+func (ClaimLimitRemoveCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "remove",
+		Description:     i18n.HelpClaimLimitRemove,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewOptionalAutocompleteableArgument("team", "Support team to remove the limit from, instead of the whole server", interaction.OptionTypeInteger, i18n.MessageClaimLimitInvalidTeam, supportTeamAutoCompleteHandler),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c ClaimLimitRemoveCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (ClaimLimitRemoveCommand) Execute(ctx registry.CommandContext, teamId *int) {
+	team, ok, err := getSupportTeam(ctx, teamId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageClaimLimitInvalidTeam)
+		return
+	}
+
+	removed, err := dbclient.Storage.ClaimLimits.Delete(ctx, ctx.GuildId(), teamId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !removed {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageClaimLimitNotSet)
+		return
+	}
+
+	if team == nil {
+		ctx.Reply(customisation.Green, i18n.TitleClaimLimit, i18n.MessageClaimLimitRemoveGuild)
+	} else {
+		ctx.Reply(customisation.Green, i18n.TitleClaimLimit, i18n.MessageClaimLimitRemoveTeam, team.Name)
+	}
+}
@@ -0,0 +1,65 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type ClaimLimitSetCommand struct {
+}
+
+func (ClaimLimitSetCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "set",
+		Description:     i18n.HelpClaimLimitSet,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("limit", "Maximum number of open tickets a staff member can have claimed at once", interaction.OptionTypeInteger, i18n.MessageClaimLimitInvalidNumber),
+			command.NewOptionalAutocompleteableArgument("team", "Support team to set the limit for, instead of the whole server", interaction.OptionTypeInteger, i18n.MessageClaimLimitInvalidTeam, supportTeamAutoCompleteHandler),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c ClaimLimitSetCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (ClaimLimitSetCommand) Execute(ctx registry.CommandContext, limit int, teamId *int) {
+	if limit < 1 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageClaimLimitInvalidNumber)
+		return
+	}
+
+	team, ok, err := getSupportTeam(ctx, teamId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageClaimLimitInvalidTeam)
+		return
+	}
+
+	if err := dbclient.Storage.ClaimLimits.Set(ctx, ctx.GuildId(), teamId, limit); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if team == nil {
+		ctx.Reply(customisation.Green, i18n.TitleClaimLimit, i18n.MessageClaimLimitSetGuild, limit)
+	} else {
+		ctx.Reply(customisation.Green, i18n.TitleClaimLimit, i18n.MessageClaimLimitSetTeam, team.Name, limit)
+	}
+}
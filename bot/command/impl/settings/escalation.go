@@ -0,0 +1,59 @@
+package settings
+
+import (
+	"strings"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type EscalationCommand struct {
+}
+
+func (EscalationCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "escalation",
+		Description:     i18n.HelpEscalation,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Children: []registry.Command{
+			EscalationSetCommand{},
+			EscalationDisableCommand{},
+		},
+	}
+}
+
+func (c EscalationCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (EscalationCommand) Execute(ctx registry.CommandContext) {
+	// Can't call a parent command
+}
+
+var escalationTargetNames = map[storage.EscalationTarget]string{
+	storage.EscalationTargetOnCall: "On-call staff",
+	storage.EscalationTargetTeam:   "Support team",
+	storage.EscalationTargetAdmins: "Admins",
+	storage.EscalationTargetUser:   "Specific user",
+}
+
+func escalationTargetAutoCompleteHandler(_ interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	var choices []interaction.ApplicationCommandOptionChoice
+	for _, target := range storage.EscalationTargets {
+		name := escalationTargetNames[target]
+		if value == "" || strings.Contains(strings.ToLower(name), strings.ToLower(value)) {
+			choices = append(choices, interaction.ApplicationCommandOptionChoice{
+				Name:  name,
+				Value: string(target),
+			})
+		}
+	}
+
+	return choices
+}
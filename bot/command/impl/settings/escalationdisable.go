@@ -0,0 +1,47 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type EscalationDisableCommand struct {
+}
+
+func (EscalationDisableCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:             "disable",
+		Description:      i18n.HelpEscalationDisable,
+		Type:             interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel:  permission.Admin,
+		Category:         command.Settings,
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c EscalationDisableCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (EscalationDisableCommand) Execute(ctx registry.CommandContext) {
+	removed, err := dbclient.Storage.EscalationPolicies.Delete(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !removed {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageEscalationNotEnabled)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleEscalation, i18n.MessageEscalationDisableSuccess)
+}
@@ -0,0 +1,109 @@
+package settings
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type EscalationSetCommand struct {
+}
+
+const defaultEscalationMaxPings = 3
+
+func (EscalationSetCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "set",
+		Description:     i18n.HelpEscalationSet,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("after", "Minutes without a staff response before pinging", interaction.OptionTypeInteger, i18n.MessageEscalationInvalidDuration),
+			command.NewRequiredAutocompleteableArgument("target", "Who to ping", interaction.OptionTypeString, i18n.MessageEscalationInvalidTarget, escalationTargetAutoCompleteHandler),
+			command.NewOptionalAutocompleteableArgument("team", "Support team to ping, if the target is a support team", interaction.OptionTypeInteger, i18n.MessageClaimLimitInvalidTeam, supportTeamAutoCompleteHandler),
+			command.NewOptionalArgument("user", "User to ping, if the target is a specific user", interaction.OptionTypeUser, i18n.MessageInvalidUser),
+			command.NewOptionalArgument("interval", "Minutes between repeated pings (defaults to the initial delay)", interaction.OptionTypeInteger, i18n.MessageEscalationInvalidDuration),
+			command.NewOptionalArgument("max_pings", "Maximum number of pings per ticket, or 0 to repeat until staff respond", interaction.OptionTypeInteger, i18n.MessageInvalidArgument),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c EscalationSetCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (EscalationSetCommand) Execute(ctx registry.CommandContext, after int, target string, teamId *int, userId *uint64, interval, maxPings *int) {
+	if after < 1 || (interval != nil && *interval < 1) {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageEscalationInvalidDuration)
+		return
+	}
+
+	policy := storage.EscalationPolicy{
+		After:    time.Duration(after) * time.Minute,
+		Interval: time.Duration(after) * time.Minute,
+		MaxPings: defaultEscalationMaxPings,
+		Target:   storage.EscalationTarget(target),
+	}
+
+	if interval != nil {
+		policy.Interval = time.Duration(*interval) * time.Minute
+	}
+
+	if maxPings != nil && *maxPings >= 0 {
+		policy.MaxPings = *maxPings
+	}
+
+	var targetName string
+	switch policy.Target {
+	case storage.EscalationTargetTeam:
+		if teamId == nil {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageEscalationTeamRequired)
+			return
+		}
+
+		team, ok, err := getSupportTeam(ctx, teamId)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if !ok {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageClaimLimitInvalidTeam)
+			return
+		}
+
+		policy.TeamId = team.Id
+		targetName = team.Name
+	case storage.EscalationTargetUser:
+		if userId == nil {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageEscalationUserRequired)
+			return
+		}
+
+		policy.UserId = *userId
+		targetName = fmt.Sprintf("<@%d>", *userId)
+	case storage.EscalationTargetOnCall, storage.EscalationTargetAdmins:
+		targetName = escalationTargetNames[policy.Target]
+	default:
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageEscalationInvalidTarget)
+		return
+	}
+
+	if err := dbclient.Storage.EscalationPolicies.Set(ctx, ctx.GuildId(), policy); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleEscalation, i18n.MessageEscalationSetSuccess, targetName, after)
+}
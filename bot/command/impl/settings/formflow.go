@@ -0,0 +1,85 @@
+package settings
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/common/sentry"
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type FormFlowCommand struct {
+}
+
+func (FormFlowCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "formflow",
+		Description:     i18n.HelpFormFlow,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Children: []registry.Command{
+			FormFlowBranchCommand{},
+			FormFlowDefaultCommand{},
+			FormFlowClearCommand{},
+		},
+	}
+}
+
+func (c FormFlowCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (FormFlowCommand) Execute(ctx registry.CommandContext) {
+	// Can't call a parent command
+}
+
+// getGuildForm returns the form with the given ID, or false if it does not belong to the guild
+func getGuildForm(ctx context.Context, guildId uint64, formId int) (database.Form, bool, error) {
+	form, ok, err := dbclient.Client.Forms.Get(ctx, formId)
+	if err != nil || !ok || form.GuildId != guildId {
+		return database.Form{}, false, err
+	}
+
+	return form, true, nil
+}
+
+func formAutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	if data.GuildId.Value == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+
+	forms, err := dbclient.Client.Forms.GetForms(ctx, data.GuildId.Value)
+	if err != nil {
+		sentry.Error(err)
+		return nil
+	}
+
+	choices := make([]interaction.ApplicationCommandOptionChoice, 0, 25)
+	for _, form := range forms {
+		if value != "" && !strings.Contains(strings.ToLower(form.Title), strings.ToLower(value)) {
+			continue
+		}
+
+		choices = append(choices, interaction.ApplicationCommandOptionChoice{
+			Name:  form.Title,
+			Value: form.Id,
+		})
+
+		if len(choices) == 25 {
+			break
+		}
+	}
+
+	return choices
+}
@@ -0,0 +1,119 @@
+package settings
+
+import (
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+const maxFormBranches = 10
+
+type FormFlowBranchCommand struct {
+}
+
+func (FormFlowBranchCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "branch",
+		Description:     i18n.HelpFormFlowBranch,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("form", "Form to add the branch to", interaction.OptionTypeInteger, i18n.MessageFormFlowInvalidForm, formAutoCompleteHandler),
+			command.NewRequiredAutocompleteableArgument("input", "Input on the form whose answer is checked", interaction.OptionTypeInteger, i18n.MessageFormFlowInvalidInput, formInputAutoCompleteHandler),
+			command.NewRequiredArgument("answers", "Comma separated answers that lead to the next form", interaction.OptionTypeString, i18n.MessageInvalidArgument),
+			command.NewRequiredAutocompleteableArgument("next_form", "Form to show next if the input has one of the answers", interaction.OptionTypeInteger, i18n.MessageFormFlowInvalidForm, formAutoCompleteHandler),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c FormFlowBranchCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (FormFlowBranchCommand) Execute(ctx registry.CommandContext, formId, inputId int, answersRaw string, nextFormId int) {
+	form, ok, err := getGuildForm(ctx, ctx.GuildId(), formId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormFlowInvalidForm)
+		return
+	}
+
+	nextForm, ok, err := getGuildForm(ctx, ctx.GuildId(), nextFormId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormFlowInvalidForm)
+		return
+	}
+
+	if nextForm.Id == form.Id {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormFlowSameForm)
+		return
+	}
+
+	// Branches can only check inputs from the form itself, as earlier steps may have been skipped
+	input, ok, err := getGuildFormInput(ctx, ctx.GuildId(), inputId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok || input.FormId != form.Id {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormFlowInvalidInput, form.Title)
+		return
+	}
+
+	var answers []string
+	for _, answer := range strings.Split(answersRaw, ",") {
+		if answer = strings.TrimSpace(answer); answer != "" {
+			answers = append(answers, answer)
+		}
+	}
+
+	if len(answers) == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageInvalidArgument)
+		return
+	}
+
+	flow, _, err := dbclient.Storage.FormFlows.Get(ctx, ctx.GuildId(), form.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if len(flow.Branches) >= maxFormBranches {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormFlowTooManyBranches, maxFormBranches)
+		return
+	}
+
+	flow.Branches = append(flow.Branches, storage.FormBranch{
+		InputId:    input.Id,
+		Values:     answers,
+		NextFormId: nextForm.Id,
+	})
+
+	if err := dbclient.Storage.FormFlows.Set(ctx, ctx.GuildId(), form.Id, flow); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleFormFlow, i18n.MessageFormFlowBranchSuccess, form.Title, input.Label, strings.Join(answers, ", "), nextForm.Title)
+}
@@ -0,0 +1,61 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type FormFlowClearCommand struct {
+}
+
+func (FormFlowClearCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "clear",
+		Description:     i18n.HelpFormFlowClear,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("form", "Form to remove the branches from", interaction.OptionTypeInteger, i18n.MessageFormFlowInvalidForm, formAutoCompleteHandler),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c FormFlowClearCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (FormFlowClearCommand) Execute(ctx registry.CommandContext, formId int) {
+	form, ok, err := getGuildForm(ctx, ctx.GuildId(), formId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormFlowInvalidForm)
+		return
+	}
+
+	removed, err := dbclient.Storage.FormFlows.Delete(ctx, ctx.GuildId(), form.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !removed {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormFlowClearNotFound, form.Title)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleFormFlow, i18n.MessageFormFlowClearSuccess, form.Title)
+}
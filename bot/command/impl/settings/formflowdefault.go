@@ -0,0 +1,92 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type FormFlowDefaultCommand struct {
+}
+
+func (FormFlowDefaultCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "default",
+		Description:     i18n.HelpFormFlowDefault,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("form", "Form to set the next form of", interaction.OptionTypeInteger, i18n.MessageFormFlowInvalidForm, formAutoCompleteHandler),
+			command.NewOptionalAutocompleteableArgument("next_form", "Form to show next if no branch matches. Leave blank to open the ticket instead", interaction.OptionTypeInteger, i18n.MessageFormFlowInvalidForm, formAutoCompleteHandler),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c FormFlowDefaultCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (FormFlowDefaultCommand) Execute(ctx registry.CommandContext, formId int, nextFormId *int) {
+	form, ok, err := getGuildForm(ctx, ctx.GuildId(), formId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormFlowInvalidForm)
+		return
+	}
+
+	flow, _, err := dbclient.Storage.FormFlows.Get(ctx, ctx.GuildId(), form.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if nextFormId == nil {
+		flow.DefaultNextFormId = 0
+
+		if err := dbclient.Storage.FormFlows.Set(ctx, ctx.GuildId(), form.Id, flow); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleFormFlow, i18n.MessageFormFlowDefaultRemoved, form.Title)
+		return
+	}
+
+	nextForm, ok, err := getGuildForm(ctx, ctx.GuildId(), *nextFormId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormFlowInvalidForm)
+		return
+	}
+
+	if nextForm.Id == form.Id {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormFlowSameForm)
+		return
+	}
+
+	flow.DefaultNextFormId = nextForm.Id
+
+	if err := dbclient.Storage.FormFlows.Set(ctx, ctx.GuildId(), form.Id, flow); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleFormFlow, i18n.MessageFormFlowDefaultSuccess, form.Title, nextForm.Title)
+}
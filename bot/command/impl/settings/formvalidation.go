@@ -0,0 +1,135 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/common/sentry"
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type FormValidationCommand struct {
+}
+
+func (FormValidationCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "formvalidation",
+		Description:     i18n.HelpFormValidation,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Children: []registry.Command{
+			FormValidationSetCommand{},
+			FormValidationRemoveCommand{},
+		},
+	}
+}
+
+func (c FormValidationCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (FormValidationCommand) Execute(ctx registry.CommandContext) {
+	// Can't call a parent command
+}
+
+var formValidationTypeNames = map[storage.FormValidationType]string{
+	storage.FormValidationText:      "Text (length only)",
+	storage.FormValidationRegex:     "Regex",
+	storage.FormValidationInteger:   "Integer",
+	storage.FormValidationDecimal:   "Decimal",
+	storage.FormValidationEmail:     "Email",
+	storage.FormValidationUrl:       "URL",
+	storage.FormValidationSnowflake: "Discord ID",
+}
+
+// getGuildFormInput returns the form input with the given ID, or false if it does not belong to the guild
+func getGuildFormInput(ctx context.Context, guildId uint64, inputId int) (database.FormInput, bool, error) {
+	input, ok, err := dbclient.Client.FormInput.Get(ctx, inputId)
+	if err != nil || !ok {
+		return database.FormInput{}, false, err
+	}
+
+	if _, ok, err := getGuildForm(ctx, guildId, input.FormId); err != nil || !ok {
+		return database.FormInput{}, false, err
+	}
+
+	return input, true, nil
+}
+
+func formInputAutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	if data.GuildId.Value == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+
+	forms, err := dbclient.Client.Forms.GetForms(ctx, data.GuildId.Value)
+	if err != nil {
+		sentry.Error(err)
+		return nil
+	}
+
+	inputs, err := dbclient.Client.FormInput.GetInputsForGuild(ctx, data.GuildId.Value)
+	if err != nil {
+		sentry.Error(err)
+		return nil
+	}
+
+	choices := make([]interaction.ApplicationCommandOptionChoice, 0, 25)
+	for _, form := range forms {
+		formInputs := inputs[form.Id]
+		sort.Slice(formInputs, func(i, j int) bool {
+			return formInputs[i].Position < formInputs[j].Position
+		})
+
+		for _, input := range formInputs {
+			name := fmt.Sprintf("%s: %s", form.Title, input.Label)
+			if value != "" && !strings.Contains(strings.ToLower(name), strings.ToLower(value)) {
+				continue
+			}
+
+			// Choice names are limited to 100 characters
+			if runes := []rune(name); len(runes) > 100 {
+				name = string(runes[:97]) + "..."
+			}
+
+			choices = append(choices, interaction.ApplicationCommandOptionChoice{
+				Name:  name,
+				Value: input.Id,
+			})
+
+			if len(choices) == 25 {
+				return choices
+			}
+		}
+	}
+
+	return choices
+}
+
+func formValidationTypeAutoCompleteHandler(_ interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	var choices []interaction.ApplicationCommandOptionChoice
+	for _, validationType := range storage.FormValidationTypes {
+		name := formValidationTypeNames[validationType]
+		if value == "" || strings.Contains(strings.ToLower(name), strings.ToLower(value)) {
+			choices = append(choices, interaction.ApplicationCommandOptionChoice{
+				Name:  name,
+				Value: string(validationType),
+			})
+		}
+	}
+
+	return choices
+}
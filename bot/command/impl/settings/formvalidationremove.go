@@ -0,0 +1,61 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type FormValidationRemoveCommand struct {
+}
+
+func (FormValidationRemoveCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "remove",
+		Description:     i18n.HelpFormValidationRemove,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("input", "Form input to remove the validation rule from", interaction.OptionTypeInteger, i18n.MessageFormValidationInvalidInput, formInputAutoCompleteHandler),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c FormValidationRemoveCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (FormValidationRemoveCommand) Execute(ctx registry.CommandContext, inputId int) {
+	input, ok, err := getGuildFormInput(ctx, ctx.GuildId(), inputId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormValidationInvalidInput)
+		return
+	}
+
+	removed, err := dbclient.Storage.FormValidation.Delete(ctx, ctx.GuildId(), input.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !removed {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormValidationRemoveNotFound, input.Label)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleFormValidation, i18n.MessageFormValidationRemoveSuccess, input.Label)
+}
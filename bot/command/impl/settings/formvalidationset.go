@@ -0,0 +1,109 @@
+package settings
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type FormValidationSetCommand struct {
+}
+
+func (FormValidationSetCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "set",
+		Description:     i18n.HelpFormValidationSet,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("input", "Form input to validate the answers of", interaction.OptionTypeInteger, i18n.MessageFormValidationInvalidInput, formInputAutoCompleteHandler),
+			command.NewRequiredAutocompleteableArgument("type", "Type of answer that the input accepts", interaction.OptionTypeString, i18n.MessageFormValidationInvalidType, formValidationTypeAutoCompleteHandler),
+			command.NewOptionalArgument("pattern", "Regular expression that answers must match", interaction.OptionTypeString, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("error_message", "Message shown when an answer does not match the pattern", interaction.OptionTypeString, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("min_length", "Minimum number of characters in an answer", interaction.OptionTypeInteger, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("max_length", "Maximum number of characters in an answer", interaction.OptionTypeInteger, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("min", "Smallest number accepted by integer and decimal inputs", interaction.OptionTypeNumber, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("max", "Largest number accepted by integer and decimal inputs", interaction.OptionTypeNumber, i18n.MessageInvalidArgument),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c FormValidationSetCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (FormValidationSetCommand) Execute(ctx registry.CommandContext, inputId int, validationType string, pattern, errorMessage *string, minLength, maxLength *int, minValue, maxValue *float64) {
+	input, ok, err := getGuildFormInput(ctx, ctx.GuildId(), inputId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormValidationInvalidInput)
+		return
+	}
+
+	rule := storage.FormValidationRule{
+		Type:      storage.FormValidationType(validationType),
+		MinLength: minLength,
+		MaxLength: maxLength,
+	}
+
+	if !rule.Type.IsValid() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormValidationInvalidType)
+		return
+	}
+
+	if rule.Type == storage.FormValidationRegex {
+		if pattern == nil {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormValidationMissingPattern)
+			return
+		}
+
+		if _, err := regexp.Compile(*pattern); err != nil {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormValidationInvalidPattern, err.Error())
+			return
+		}
+
+		rule.Pattern = *pattern
+		if errorMessage != nil {
+			if len(*errorMessage) > 255 {
+				ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormValidationErrorMessageTooLong)
+				return
+			}
+
+			rule.ErrorMessage = *errorMessage
+		}
+	}
+
+	if rule.Type == storage.FormValidationInteger || rule.Type == storage.FormValidationDecimal {
+		rule.Min = minValue
+		rule.Max = maxValue
+	}
+
+	if (minLength != nil && *minLength < 0) || (maxLength != nil && *maxLength < 0) ||
+		(minLength != nil && maxLength != nil && *minLength > *maxLength) ||
+		(rule.Min != nil && rule.Max != nil && *rule.Min > *rule.Max) {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageFormValidationInvalidBounds)
+		return
+	}
+
+	if err := dbclient.Storage.FormValidation.Set(ctx, ctx.GuildId(), input.Id, rule); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleFormValidation, i18n.MessageFormValidationSetSuccess, input.Label, formValidationTypeNames[rule.Type])
+}
@@ -0,0 +1,53 @@
+package settings
+
+import (
+	"strings"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type PrioritySettingsCommand struct {
+}
+
+func (PrioritySettingsCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "prioritysettings",
+		Description:     i18n.HelpPrioritySettings,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Children: []registry.Command{
+			PrioritySettingsGeneralCommand{},
+			PrioritySettingsPanelDefaultCommand{},
+		},
+	}
+}
+
+func (c PrioritySettingsCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (PrioritySettingsCommand) Execute(ctx registry.CommandContext) {
+	// Can't call a parent command
+}
+
+func priorityAutoCompleteHandler(_ interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	var choices []interaction.ApplicationCommandOptionChoice
+	for _, priority := range storage.Priorities {
+		name := logic.PriorityNames[priority]
+		if value == "" || strings.Contains(strings.ToLower(name), strings.ToLower(value)) {
+			choices = append(choices, interaction.ApplicationCommandOptionChoice{
+				Name:  name,
+				Value: string(priority),
+			})
+		}
+	}
+
+	return choices
+}
@@ -0,0 +1,65 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type PrioritySettingsGeneralCommand struct {
+}
+
+func (PrioritySettingsGeneralCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "general",
+		Description:     i18n.HelpPrioritySettingsGeneral,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewOptionalArgument("question", "Label of the form question whose answer sets the priority of new tickets", interaction.OptionTypeString, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("urgent_role", "Role to ping instead of the panel's mentions when an urgent ticket is opened", interaction.OptionTypeRole, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("reorder", "Whether to sort ticket channels by priority within their category", interaction.OptionTypeBoolean, i18n.MessageInvalidArgument),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c PrioritySettingsGeneralCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (PrioritySettingsGeneralCommand) Execute(ctx registry.CommandContext, question *string, urgentRoleId *uint64, reorder *bool) {
+	settings, err := dbclient.Storage.PrioritySettings.Get(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Panel defaults are set separately, so are kept
+	settings.FormQuestion = ""
+	if question != nil {
+		settings.FormQuestion = *question
+	}
+
+	settings.UrgentRoleId = 0
+	if urgentRoleId != nil {
+		settings.UrgentRoleId = *urgentRoleId
+	}
+
+	settings.Reorder = reorder != nil && *reorder
+
+	if err := dbclient.Storage.PrioritySettings.Set(ctx, ctx.GuildId(), settings); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitlePriority, i18n.MessagePrioritySettingsSuccess)
+}
@@ -0,0 +1,80 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type PrioritySettingsPanelDefaultCommand struct {
+}
+
+func (PrioritySettingsPanelDefaultCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "paneldefault",
+		Description:     i18n.HelpPrioritySettingsPanelDefault,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("panel", "Panel to set the default priority for", interaction.OptionTypeInteger, i18n.MessagePriorityInvalidPanel, panelAutoCompleteHandler),
+			command.NewRequiredAutocompleteableArgument("priority", "Priority that tickets opened from the panel start with", interaction.OptionTypeString, i18n.MessagePriorityInvalid, priorityAutoCompleteHandler),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c PrioritySettingsPanelDefaultCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (PrioritySettingsPanelDefaultCommand) Execute(ctx registry.CommandContext, panelId int, priorityRaw string) {
+	panel, err := dbclient.Client.Panel.GetById(ctx, panelId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if panel.PanelId == 0 || panel.GuildId != ctx.GuildId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePriorityInvalidPanel)
+		return
+	}
+
+	priority, ok := logic.ParsePriority(priorityRaw)
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePriorityInvalid)
+		return
+	}
+
+	settings, err := dbclient.Storage.PrioritySettings.Get(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if priority == storage.PriorityNormal {
+		delete(settings.PanelDefaults, panel.PanelId)
+	} else {
+		if settings.PanelDefaults == nil {
+			settings.PanelDefaults = make(map[int]storage.TicketPriority)
+		}
+
+		settings.PanelDefaults[panel.PanelId] = priority
+	}
+
+	if err := dbclient.Storage.PrioritySettings.Set(ctx, ctx.GuildId(), settings); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitlePriority, i18n.MessagePrioritySettingsSuccess)
+}
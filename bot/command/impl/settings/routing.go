@@ -0,0 +1,76 @@
+package settings
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/common/sentry"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/impl/tickets"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+const maxRoutingRules = 25
+
+type RoutingCommand struct {
+}
+
+func (RoutingCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "routing",
+		Description:     i18n.HelpRouting,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Children: []registry.Command{
+			RoutingAddCommand{},
+			RoutingRemoveCommand{},
+			RoutingListCommand{},
+			RoutingDryRunCommand{},
+		},
+	}
+}
+
+func (c RoutingCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (RoutingCommand) Execute(ctx registry.CommandContext) {
+	// Can't call a parent command
+}
+
+func routingRuleAutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	if data.GuildId.Value == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+
+	settings, err := dbclient.Storage.RoutingSettings.Get(ctx, data.GuildId.Value)
+	if err != nil {
+		sentry.Error(err)
+		return nil
+	}
+
+	choices := make([]interaction.ApplicationCommandOptionChoice, 0, 25)
+	for _, rule := range settings.Rules {
+		if value == "" || strings.Contains(strings.ToLower(rule.Name), strings.ToLower(value)) {
+			choices = append(choices, interaction.ApplicationCommandOptionChoice{
+				Name:  rule.Name,
+				Value: rule.Name,
+			})
+		}
+	}
+
+	return choices
+}
+
+func labelAutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	return tickets.TicketsListCommand{}.LabelAutoCompleteHandler(data, value)
+}
@@ -0,0 +1,218 @@
+package settings
+
+import (
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/channel"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type RoutingAddCommand struct {
+}
+
+func (RoutingAddCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "add",
+		Description:     i18n.HelpRoutingAdd,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("name", "Name of the rule", interaction.OptionTypeString, i18n.MessageInvalidArgument),
+			command.NewOptionalAutocompleteableArgument("panel", "Only match tickets opened from this panel", interaction.OptionTypeInteger, i18n.MessageRoutingInvalidPanel, panelAutoCompleteHandler),
+			command.NewOptionalArgument("question", "Label of the form question whose answer is checked", interaction.OptionTypeString, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("answers", "Comma separated answers to the question that match", interaction.OptionTypeString, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("role", "Only match tickets opened by members with this role", interaction.OptionTypeRole, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("locale", "Only match tickets opened by users with this Discord language, e.g. de or en-US", interaction.OptionTypeString, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("hours", "Only match tickets opened between these times, e.g. 09:00-17:00", interaction.OptionTypeString, i18n.MessageRoutingInvalidTimeRange),
+			command.NewOptionalArgument("timezone", "Timezone of the hours, e.g. Europe/London. Defaults to UTC", interaction.OptionTypeString, i18n.MessageRoutingInvalidTimeRange),
+			command.NewOptionalArgument("category", "Category to open matching tickets in", interaction.OptionTypeChannel, i18n.MessageRoutingInvalidCategory),
+			command.NewOptionalAutocompleteableArgument("team", "Support team to give access to matching tickets, in place of the panel's teams", interaction.OptionTypeInteger, i18n.MessageRoutingInvalidTeam, supportTeamAutoCompleteHandler),
+			command.NewOptionalArgument("mention", "Role to mention in place of the panel's mentions", interaction.OptionTypeRole, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("naming_scheme", "Naming scheme for matching tickets, e.g. billing-%id%", interaction.OptionTypeString, i18n.MessageInvalidArgument),
+			command.NewOptionalAutocompleteableArgument("label", "Label to add to matching tickets", interaction.OptionTypeInteger, i18n.MessageRoutingInvalidLabel, labelAutoCompleteHandler),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c RoutingAddCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (RoutingAddCommand) Execute(
+	ctx registry.CommandContext,
+	name string,
+	panelId *int,
+	question, answers *string,
+	roleId *uint64,
+	locale, hours, timezone *string,
+	categoryId *uint64,
+	teamId *int,
+	mentionRoleId *uint64,
+	namingScheme *string,
+	labelId *int,
+) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageInvalidArgument)
+		return
+	}
+
+	settings, err := dbclient.Storage.RoutingSettings.Get(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if len(settings.Rules) >= maxRoutingRules {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRoutingTooManyRules, maxRoutingRules)
+		return
+	}
+
+	for _, rule := range settings.Rules {
+		if strings.EqualFold(rule.Name, name) {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRoutingDuplicateName, name)
+			return
+		}
+	}
+
+	rule := storage.RoutingRule{Name: name}
+
+	if panelId != nil {
+		panel, err := dbclient.Client.Panel.GetById(ctx, *panelId)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if panel.PanelId == 0 || panel.GuildId != ctx.GuildId() {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRoutingInvalidPanel)
+			return
+		}
+
+		rule.PanelId = panel.PanelId
+	}
+
+	if (question == nil) != (answers == nil) {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRoutingMissingAnswers)
+		return
+	}
+
+	if question != nil {
+		rule.Question = strings.TrimSpace(*question)
+		for _, answer := range strings.Split(*answers, ",") {
+			if answer = strings.TrimSpace(answer); answer != "" {
+				rule.Answers = append(rule.Answers, answer)
+			}
+		}
+
+		if rule.Question == "" || len(rule.Answers) == 0 {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRoutingMissingAnswers)
+			return
+		}
+	}
+
+	if roleId != nil {
+		rule.RoleId = *roleId
+	}
+
+	if locale != nil {
+		rule.Locale = strings.TrimSpace(*locale)
+	}
+
+	if hours != nil {
+		var tz string
+		if timezone != nil {
+			tz = strings.TrimSpace(*timezone)
+		}
+
+		timeRange, err := logic.ParseRoutingTimeRange(*hours, tz)
+		if err != nil {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRoutingInvalidTimeRange)
+			return
+		}
+
+		rule.TimeRange = &timeRange
+	}
+
+	if categoryId != nil {
+		ch, err := ctx.Worker().GetChannel(*categoryId)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if ch.Type != channel.ChannelTypeGuildCategory || ch.GuildId != ctx.GuildId() {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRoutingInvalidCategory)
+			return
+		}
+
+		rule.CategoryId = ch.Id
+	}
+
+	if teamId != nil {
+		team, ok, err := dbclient.Client.SupportTeam.GetById(ctx, ctx.GuildId(), *teamId)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if !ok {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRoutingInvalidTeam)
+			return
+		}
+
+		rule.TeamIds = []int{team.Id}
+	}
+
+	if mentionRoleId != nil {
+		rule.MentionRoleIds = []uint64{*mentionRoleId}
+	}
+
+	if namingScheme != nil {
+		rule.NamingScheme = strings.TrimSpace(*namingScheme)
+		if len(rule.NamingScheme) > 100 {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageInvalidArgument)
+			return
+		}
+	}
+
+	if labelId != nil {
+		label, ok, err := dbclient.Client.TicketLabels.Get(ctx, ctx.GuildId(), *labelId)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if !ok {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRoutingInvalidLabel)
+			return
+		}
+
+		rule.LabelIds = []int{label.LabelId}
+	}
+
+	if rule.CategoryId == 0 && len(rule.TeamIds) == 0 && len(rule.MentionRoleIds) == 0 && rule.NamingScheme == "" && len(rule.LabelIds) == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRoutingNoOverrides)
+		return
+	}
+
+	settings.Rules = append(settings.Rules, rule)
+	if err := dbclient.Storage.RoutingSettings.Set(ctx, ctx.GuildId(), settings); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleRouting, i18n.MessageRoutingAddSuccess, rule.Name, len(settings.Rules))
+}
@@ -0,0 +1,84 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/channel"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type RoutingDryRunCommand struct {
+}
+
+func (RoutingDryRunCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "dryrun",
+		Description:     i18n.HelpRoutingDryRun,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("enabled", "Whether to only report the rule each new ticket matches, rather than applying it", interaction.OptionTypeBoolean, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("channel", "Channel to report matched rules in", interaction.OptionTypeChannel, i18n.MessageRoutingDryRunInvalidChannel),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c RoutingDryRunCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (RoutingDryRunCommand) Execute(ctx registry.CommandContext, enabled bool, channelId *uint64) {
+	settings, err := dbclient.Storage.RoutingSettings.Get(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !enabled {
+		settings.DryRun = false
+		settings.DryRunChannelId = 0
+
+		if err := dbclient.Storage.RoutingSettings.Set(ctx, ctx.GuildId(), settings); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleRouting, i18n.MessageRoutingDryRunDisabled)
+		return
+	}
+
+	if channelId == nil {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRoutingDryRunInvalidChannel)
+		return
+	}
+
+	ch, err := ctx.Worker().GetChannel(*channelId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ch.Type != channel.ChannelTypeGuildText || ch.GuildId != ctx.GuildId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRoutingDryRunInvalidChannel)
+		return
+	}
+
+	settings.DryRun = true
+	settings.DryRunChannelId = ch.Id
+
+	if err := dbclient.Storage.RoutingSettings.Set(ctx, ctx.GuildId(), settings); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleRouting, i18n.MessageRoutingDryRunEnabled, ch.Mention())
+}
@@ -0,0 +1,114 @@
+package settings
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type RoutingListCommand struct {
+}
+
+func (RoutingListCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:             "list",
+		Description:      i18n.HelpRoutingList,
+		Type:             interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel:  permission.Admin,
+		Category:         command.Settings,
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c RoutingListCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (RoutingListCommand) Execute(ctx registry.CommandContext) {
+	settings, err := dbclient.Storage.RoutingSettings.Get(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if len(settings.Rules) == 0 {
+		ctx.Reply(customisation.Green, i18n.TitleRouting, i18n.MessageRoutingListEmpty)
+		return
+	}
+
+	lines := make([]string, len(settings.Rules))
+	for i, rule := range settings.Rules {
+		lines[i] = fmt.Sprintf("`%d.` **%s**: %s → %s", i+1, rule.Name, describeRoutingConditions(rule), describeRoutingOverrides(rule))
+	}
+
+	if settings.DryRun {
+		ctx.Reply(customisation.Green, i18n.TitleRouting, i18n.MessageRoutingListDryRun, strings.Join(lines, "\n"), fmt.Sprintf("<#%d>", settings.DryRunChannelId))
+	} else {
+		ctx.Reply(customisation.Green, i18n.TitleRouting, i18n.MessageRoutingList, strings.Join(lines, "\n"))
+	}
+}
+
+func describeRoutingConditions(rule storage.RoutingRule) string {
+	var conditions []string
+	if rule.PanelId != 0 {
+		conditions = append(conditions, fmt.Sprintf("panel `%d`", rule.PanelId))
+	}
+
+	if rule.Question != "" {
+		conditions = append(conditions, fmt.Sprintf("`%s` is `%s`", rule.Question, strings.Join(rule.Answers, "`/`")))
+	}
+
+	if rule.RoleId != 0 {
+		conditions = append(conditions, fmt.Sprintf("<@&%d>", rule.RoleId))
+	}
+
+	if rule.Locale != "" {
+		conditions = append(conditions, fmt.Sprintf("`%s`", rule.Locale))
+	}
+
+	if rule.TimeRange != nil {
+		conditions = append(conditions, fmt.Sprintf("%02d:%02d-%02d:%02d %s",
+			rule.TimeRange.Start/60, rule.TimeRange.Start%60, rule.TimeRange.End/60, rule.TimeRange.End%60, rule.TimeRange.Timezone))
+	}
+
+	if len(conditions) == 0 {
+		return "*"
+	}
+
+	return strings.Join(conditions, ", ")
+}
+
+func describeRoutingOverrides(rule storage.RoutingRule) string {
+	var overrides []string
+	if rule.CategoryId != 0 {
+		overrides = append(overrides, fmt.Sprintf("<#%d>", rule.CategoryId))
+	}
+
+	for _, teamId := range rule.TeamIds {
+		overrides = append(overrides, fmt.Sprintf("team `%d`", teamId))
+	}
+
+	for _, roleId := range rule.MentionRoleIds {
+		overrides = append(overrides, fmt.Sprintf("<@&%d>", roleId))
+	}
+
+	if rule.NamingScheme != "" {
+		overrides = append(overrides, fmt.Sprintf("`%s`", rule.NamingScheme))
+	}
+
+	for _, labelId := range rule.LabelIds {
+		overrides = append(overrides, fmt.Sprintf("label `%d`", labelId))
+	}
+
+	return strings.Join(overrides, ", ")
+}
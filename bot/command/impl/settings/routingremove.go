@@ -0,0 +1,61 @@
+package settings
+
+import (
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type RoutingRemoveCommand struct {
+}
+
+func (RoutingRemoveCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "remove",
+		Description:     i18n.HelpRoutingRemove,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("name", "Name of the rule to remove", interaction.OptionTypeString, i18n.MessageInvalidArgument, routingRuleAutoCompleteHandler),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c RoutingRemoveCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (RoutingRemoveCommand) Execute(ctx registry.CommandContext, name string) {
+	settings, err := dbclient.Storage.RoutingSettings.Get(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	for i, rule := range settings.Rules {
+		if !strings.EqualFold(rule.Name, strings.TrimSpace(name)) {
+			continue
+		}
+
+		settings.Rules = append(settings.Rules[:i], settings.Rules[i+1:]...)
+		if err := dbclient.Storage.RoutingSettings.Set(ctx, ctx.GuildId(), settings); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleRouting, i18n.MessageRoutingRemoveSuccess, rule.Name)
+		return
+	}
+
+	ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRoutingRemoveNotFound, name)
+}
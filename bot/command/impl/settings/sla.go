@@ -0,0 +1,39 @@
+package settings
+
+import (
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/impl/tickets"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type SlaCommand struct {
+}
+
+func (SlaCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "sla",
+		Description:     i18n.HelpSla,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Children: []registry.Command{
+			SlaSetCommand{},
+			SlaRemoveCommand{},
+		},
+	}
+}
+
+func (c SlaCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (SlaCommand) Execute(ctx registry.CommandContext) {
+	// Can't call a parent command
+}
+
+func panelAutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	return tickets.SwitchPanelCommand{}.AutoCompleteHandler(data, value)
+}
@@ -0,0 +1,78 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type SlaSetCommand struct {
+}
+
+func (SlaSetCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "set",
+		Description:     i18n.HelpSlaSet,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("panel", "Panel to set the SLA targets for", interaction.OptionTypeInteger, i18n.MessageSlaInvalidPanel, panelAutoCompleteHandler),
+			command.NewOptionalArgument("first_response", "Minutes staff have to send a first response", interaction.OptionTypeInteger, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("resolution", "Minutes staff have to close the ticket", interaction.OptionTypeInteger, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("warn_before", "Minutes before a breach to warn staff", interaction.OptionTypeInteger, i18n.MessageInvalidArgument),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c SlaSetCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (SlaSetCommand) Execute(ctx registry.CommandContext, panelId int, firstResponse, resolution, warnBefore *int) {
+	panel, err := dbclient.Client.Panel.GetById(ctx, panelId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if panel.PanelId == 0 || panel.GuildId != ctx.GuildId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageSlaInvalidPanel)
+		return
+	}
+
+	policy := storage.SlaPolicy{
+		FirstResponse: minutesOrZero(firstResponse),
+		Resolution:    minutesOrZero(resolution),
+		WarnBefore:    minutesOrZero(warnBefore),
+	}
+
+	if policy.FirstResponse == 0 && policy.Resolution == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageSlaSetNoTargets)
+		return
+	}
+
+	if err := dbclient.Storage.SlaPolicies.Set(ctx, ctx.GuildId(), panel.PanelId, policy); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSla, i18n.MessageSlaSetSuccess, panel.Title)
+}
+
+func minutesOrZero(minutes *int) time.Duration {
+	if minutes == nil || *minutes <= 0 {
+		return 0
+	}
+
+	return time.Duration(*minutes) * time.Minute
+}
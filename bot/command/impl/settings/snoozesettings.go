@@ -0,0 +1,66 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/channel"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type SnoozeSettingsCommand struct {
+}
+
+func (SnoozeSettingsCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "snoozesettings",
+		Description:     i18n.HelpSnoozeSettings,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewOptionalArgument("category", "Category to move snoozed tickets into, or leave empty to keep them in place", interaction.OptionTypeChannel, i18n.MessageSnoozeInvalidCategory),
+			command.NewOptionalArgument("button", "Whether to show a snooze button on the welcome message of new tickets", interaction.OptionTypeBoolean, i18n.MessageInvalidArgument),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c SnoozeSettingsCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (SnoozeSettingsCommand) Execute(ctx registry.CommandContext, categoryId *uint64, showButton *bool) {
+	settings := storage.SnoozeSettings{
+		ShowButton: showButton != nil && *showButton,
+	}
+
+	if categoryId != nil {
+		ch, err := ctx.Worker().GetChannel(*categoryId)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if ch.Type != channel.ChannelTypeGuildCategory || ch.GuildId != ctx.GuildId() {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageSnoozeInvalidCategory)
+			return
+		}
+
+		settings.CategoryId = ch.Id
+	}
+
+	if err := dbclient.Storage.SnoozeSettings.Set(ctx, ctx.GuildId(), settings); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSnooze, i18n.MessageSnoozeSettingsSuccess)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/TicketsBot-cloud/worker/bot/command/registry"
 	"github.com/TicketsBot-cloud/worker/bot/customisation"
 	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
 	"github.com/TicketsBot-cloud/worker/bot/utils"
 	"github.com/TicketsBot-cloud/worker/experiments"
 	"github.com/TicketsBot-cloud/worker/i18n"
@@ -115,6 +116,26 @@ func (StatsServerCommand) Execute(ctx registry.CommandContext) {
 		return
 	})
 
+	// SLA compliance
+	var slaStats storage.SlaStats
+	group.Go(func() (err error) {
+		span := sentry.StartSpan(span.Context(), "GetSlaStats")
+		defer span.Finish()
+
+		slaStats, err = dbclient.Storage.SlaStats.Get(ctx, ctx.GuildId())
+		return
+	})
+
+	// Escalations
+	var escalationStats storage.EscalationStats
+	group.Go(func() (err error) {
+		span := sentry.StartSpan(span.Context(), "GetEscalationStats")
+		defer span.Finish()
+
+		escalationStats, err = dbclient.Storage.TicketEscalations.GetStats(ctx, ctx.GuildId())
+		return
+	})
+
 	// tickets per day
 	var ticketVolumeTable string
 	group.Go(func() error {
@@ -158,6 +179,8 @@ func (StatsServerCommand) Execute(ctx registry.CommandContext) {
 			fmt.Sprintf("**Open Tickets**: %d", openTickets),
 			fmt.Sprintf("**Feedback Rating**: %.1f / 5 ★", feedbackRating),
 			fmt.Sprintf("**Feedback Count**: %d", feedbackCount),
+			fmt.Sprintf("**Escalated Tickets**: %d", escalationStats.Tickets),
+			fmt.Sprintf("**Escalations**: %d", escalationStats.Escalations),
 		}
 
 		responseTimeStats := []string{
@@ -172,6 +195,11 @@ func (StatsServerCommand) Execute(ctx registry.CommandContext) {
 			fmt.Sprintf("**Weekly**: %s", formatNullableTime(ticketDuration.Weekly)),
 		}
 
+		slaComplianceStats := []string{
+			fmt.Sprintf("**First Response**: %s", formatCompliance(slaStats.FirstResponseCompliance())),
+			fmt.Sprintf("**Resolution**: %s", formatCompliance(slaStats.ResolutionCompliance())),
+		}
+
 		var topSection []component.Component
 
 		iconUrl := guildData.IconUrl()
@@ -210,6 +238,10 @@ func (StatsServerCommand) Execute(ctx registry.CommandContext) {
 				Content: fmt.Sprintf("### Average Ticket Duration\n● %s", strings.Join(ticketDurationStats, "\n● ")),
 			}),
 			component.BuildSeparator(component.Separator{}),
+			component.BuildTextDisplay(component.TextDisplay{
+				Content: fmt.Sprintf("### SLA Compliance\n● %s", strings.Join(slaComplianceStats, "\n● ")),
+			}),
+			component.BuildSeparator(component.Separator{}),
 			component.BuildTextDisplay(component.TextDisplay{
 				Content: fmt.Sprintf(
 					"### Ticket Volume\n```\n%s\n```",
@@ -231,12 +263,18 @@ func (StatsServerCommand) Execute(ctx registry.CommandContext) {
 			AddField("Feedback Rating", fmt.Sprintf("%.1f / 5 ⭐", feedbackRating), true).
 			AddField("Feedback Count", strconv.FormatUint(feedbackCount, 10), true).
 			AddBlankField(true).
+			AddField("Escalated Tickets", strconv.FormatInt(escalationStats.Tickets, 10), true).
+			AddField("Escalations", strconv.FormatInt(escalationStats.Escalations, 10), true).
+			AddBlankField(true).
 			AddField("Average First Response Time (Total)", formatNullableTime(firstResponseTime.AllTime), true).
 			AddField("Average First Response Time (Monthly)", formatNullableTime(firstResponseTime.Monthly), true).
 			AddField("Average First Response Time (Weekly)", formatNullableTime(firstResponseTime.Weekly), true).
 			AddField("Average Ticket Duration (Total)", formatNullableTime(ticketDuration.AllTime), true).
 			AddField("Average Ticket Duration (Monthly)", formatNullableTime(ticketDuration.Monthly), true).
 			AddField("Average Ticket Duration (Weekly)", formatNullableTime(ticketDuration.Weekly), true).
+			AddField("SLA Compliance (First Response)", formatCompliance(slaStats.FirstResponseCompliance()), true).
+			AddField("SLA Compliance (Resolution)", formatCompliance(slaStats.ResolutionCompliance()), true).
+			AddBlankField(true).
 			AddField("Ticket Volume", fmt.Sprintf("```\n%s\n```", ticketVolumeTable), false)
 
 		_, _ = ctx.ReplyWith(command.NewEphemeralEmbedMessageResponse(msgEmbed))
@@ -248,3 +286,11 @@ func (StatsServerCommand) Execute(ctx registry.CommandContext) {
 func formatNullableTime(duration *time.Duration) string {
 	return utils.FormatNullableTime(duration)
 }
+
+func formatCompliance(percentage *float64) string {
+	if percentage == nil {
+		return "No data"
+	}
+
+	return fmt.Sprintf("%.1f%%", *percentage)
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/TicketsBot-cloud/worker/bot/command/registry"
 	"github.com/TicketsBot-cloud/worker/bot/customisation"
 	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
 	"github.com/TicketsBot-cloud/worker/bot/utils"
 	"github.com/TicketsBot-cloud/worker/experiments"
 	"github.com/TicketsBot-cloud/worker/i18n"
@@ -264,6 +266,42 @@ func (StatsUserCommand) Execute(ctx registry.CommandContext, userId uint64) {
 			return
 		})
 
+		var openClaims, claimLimit int
+		group.Go(func() error {
+			span := sentry.StartSpan(span.Context(), "GetOpenClaimCounts")
+			defer span.Finish()
+
+			counts, err := logic.GetOpenClaimCounts(ctx, ctx.GuildId())
+			if err != nil {
+				return err
+			}
+
+			openClaims = counts[userId]
+			return nil
+		})
+
+		group.Go(func() error {
+			span := sentry.StartSpan(span.Context(), "LoadClaimLimits")
+			defer span.Finish()
+
+			limits, err := logic.LoadClaimLimits(ctx, ctx.GuildId())
+			if err != nil {
+				return err
+			}
+
+			claimLimit = limits.For(userId, member.Roles)
+			return nil
+		})
+
+		var slaStats storage.SlaStats
+		group.Go(func() (err error) {
+			span := sentry.StartSpan(span.Context(), "GetSlaUserStats")
+			defer span.Finish()
+
+			slaStats, err = dbclient.Storage.SlaStats.GetForUser(ctx, ctx.GuildId(), userId)
+			return
+		})
+
 		if err := group.Wait(); err != nil {
 			ctx.HandleError(err)
 			return
@@ -305,11 +343,17 @@ func (StatsUserCommand) Execute(ctx registry.CommandContext, userId uint64) {
 			}
 
 			claimedStats := []string{
+				fmt.Sprintf("**Open**: %s", formatClaimLoad(openClaims, claimLimit)),
 				fmt.Sprintf("**Total**: %d", totalClaimedTickets),
 				fmt.Sprintf("**Monthly**: %d", monthlyClaimedTickets),
 				fmt.Sprintf("**Weekly**: %d", weeklyClaimedTickets),
 			}
 
+			slaComplianceStats := []string{
+				fmt.Sprintf("**First Response**: %s", formatCompliance(slaStats.FirstResponseCompliance())),
+				fmt.Sprintf("**Resolution**: %s", formatCompliance(slaStats.ResolutionCompliance())),
+			}
+
 			var topSection []component.Component
 
 			avatarUrl := member.User.AvatarUrl(256)
@@ -357,6 +401,10 @@ func (StatsUserCommand) Execute(ctx registry.CommandContext, userId uint64) {
 						strings.Join(claimedStats, "\n● "),
 					),
 				}),
+				component.BuildSeparator(component.Separator{}),
+				component.BuildTextDisplay(component.TextDisplay{
+					Content: fmt.Sprintf("### SLA Compliance\n● %s", strings.Join(slaComplianceStats, "\n● ")),
+				}),
 			}...)
 
 			ctx.ReplyWith(command.NewEphemeralMessageResponseWithComponents(utils.Slice(component.BuildContainer(component.Container{
@@ -378,7 +426,10 @@ func (StatsUserCommand) Execute(ctx registry.CommandContext, userId uint64) {
 				AddField("Tickets Answered (Total)", fmt.Sprintf("%d / %d", totalAnsweredTickets, totalTotalTickets), true).
 				AddField("Claimed Tickets (Weekly)", strconv.Itoa(weeklyClaimedTickets), true).
 				AddField("Claimed Tickets (Monthly)", strconv.Itoa(monthlyClaimedTickets), true).
-				AddField("Claimed Tickets (Total)", strconv.Itoa(totalClaimedTickets), true)
+				AddField("Claimed Tickets (Total)", strconv.Itoa(totalClaimedTickets), true).
+				AddField("Claimed Tickets (Open)", formatClaimLoad(openClaims, claimLimit), true).
+				AddField("SLA Compliance (First Response)", formatCompliance(slaStats.FirstResponseCompliance()), true).
+				AddField("SLA Compliance (Resolution)", formatCompliance(slaStats.ResolutionCompliance()), true)
 
 			_, _ = ctx.ReplyWith(command.NewEphemeralEmbedMessageResponse(msgEmbed))
 		}
@@ -386,3 +437,11 @@ func (StatsUserCommand) Execute(ctx registry.CommandContext, userId uint64) {
 		span.Finish()
 	}
 }
+
+func formatClaimLoad(openClaims, limit int) string {
+	if limit == 0 {
+		return strconv.Itoa(openClaims)
+	}
+
+	return fmt.Sprintf("%d / %d", openClaims, limit)
+}
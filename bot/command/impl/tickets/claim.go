@@ -1,6 +1,7 @@
 package tickets
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/TicketsBot-cloud/common/permission"
@@ -53,6 +54,12 @@ func (ClaimCommand) Execute(ctx registry.CommandContext) {
 	}
 
 	if err := logic.ClaimTicket(ctx, ctx, ticket, ctx.UserId()); err != nil {
+		var limitErr *logic.ClaimLimitError
+		if errors.As(err, &limitErr) {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageClaimLimitReached, limitErr.Limit)
+			return
+		}
+
 		ctx.HandleError(err)
 		return
 	}
@@ -28,6 +28,7 @@ func (c CloseCommand) Properties() registry.Properties {
 		Category:        command.Tickets,
 		Arguments: command.Arguments(
 			command.NewOptionalAutocompleteableArgument("reason", "The reason the ticket was closed", interaction.OptionTypeString, "infallible", c.AutoCompleteHandler), // should never fail
+			command.NewOptionalArgument("in", "Close the ticket after a delay instead of straight away (e.g. 2h, 1d)", interaction.OptionTypeString, i18n.MessageScheduledCloseInvalidDelay),
 		),
 		Timeout: constants.TimeoutCloseTicket,
 	}
@@ -37,7 +38,12 @@ func (c CloseCommand) GetExecutor() interface{} {
 	return c.Execute
 }
 
-func (CloseCommand) Execute(ctx registry.CommandContext, reason *string) {
+func (CloseCommand) Execute(ctx registry.CommandContext, reason *string, delay *string) {
+	if delay != nil {
+		logic.ScheduleCloseWithReply(ctx, ctx, reason, *delay)
+		return
+	}
+
 	logic.CloseTicket(ctx, ctx, reason, false)
 }
 
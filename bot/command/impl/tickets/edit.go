@@ -13,6 +13,8 @@ import (
 	"github.com/TicketsBot-cloud/worker/bot/command/registry"
 	"github.com/TicketsBot-cloud/worker/bot/customisation"
 	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
 	"github.com/TicketsBot-cloud/worker/bot/utils"
 	"github.com/TicketsBot-cloud/worker/i18n"
 )
@@ -48,6 +50,21 @@ func (EditCommand) Execute(ctx registry.CommandContext) {
 		return
 	}
 
+	priority, err := dbclient.Storage.TicketPriorities.Get(ctx, ctx.GuildId(), ticket.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	priorityOptions := make([]component.SelectOption, len(storage.Priorities))
+	for i, p := range storage.Priorities {
+		priorityOptions[i] = component.SelectOption{
+			Label:   logic.PriorityNames[p],
+			Value:   string(p),
+			Default: p == priority,
+		}
+	}
+
 	ctx.ReplyWith(command.MessageResponse{
 		Flags: message.SumFlags(message.FlagComponentsV2),
 		Components: []component.Component{
@@ -75,6 +92,16 @@ func (EditCommand) Execute(ctx registry.CommandContext) {
 							Style:    component.ButtonStyleSecondary,
 						}),
 					}),
+					component.BuildSeparator(component.Separator{Divider: utils.Ptr(false)}),
+					component.BuildTextDisplay(component.TextDisplay{
+						Content: fmt.Sprintf("%s\n-# *%s*", ctx.GetMessage(i18n.MessageEditPriorityTitle), ctx.GetMessage(i18n.MessageEditPriorityDescription)),
+					}),
+					component.BuildActionRow(
+						component.BuildSelectMenu(component.SelectMenu{
+							CustomId: "update-ticket-priority-select",
+							Options:  priorityOptions,
+						}),
+					),
 				},
 			}),
 		},
@@ -0,0 +1,76 @@
+package tickets
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/common/sentry"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	cmdcontext "github.com/TicketsBot-cloud/worker/bot/command/context"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/constants"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type EscalateCommand struct {
+}
+
+func (c EscalateCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "escalate",
+		Description:     i18n.HelpEscalate,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		InteractionOnly: true,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("team", "Support team to escalate the ticket to", interaction.OptionTypeInteger, i18n.MessageEscalateInvalidTeam, c.AutoCompleteHandler),
+			command.NewOptionalArgument("reason", "Why the ticket is being escalated", interaction.OptionTypeString, i18n.MessageInvalidArgument),
+		),
+		Timeout: constants.TimeoutOpenTicket,
+	}
+}
+
+func (c EscalateCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (EscalateCommand) Execute(ctx *cmdcontext.SlashCommandContext, teamId int, reason *string) {
+	logic.EscalateTicketToTeam(ctx.Context, ctx, teamId, reason)
+}
+
+func (EscalateCommand) AutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	if data.GuildId.Value == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+
+	teams, err := dbclient.Client.SupportTeam.Get(ctx, data.GuildId.Value)
+	if err != nil {
+		sentry.Error(err)
+		return nil
+	}
+
+	choices := make([]interaction.ApplicationCommandOptionChoice, 0, 25)
+	for _, team := range teams {
+		if value == "" || strings.Contains(strings.ToLower(team.Name), strings.ToLower(value)) {
+			choices = append(choices, interaction.ApplicationCommandOptionChoice{
+				Name:  team.Name,
+				Value: team.Id,
+			})
+		}
+
+		if len(choices) == 25 {
+			break
+		}
+	}
+
+	return choices
+}
@@ -0,0 +1,87 @@
+package tickets
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/common/sentry"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/constants"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type MergeCommand struct {
+}
+
+func (c MergeCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "merge",
+		Description:     i18n.HelpMerge,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("ticket", "ID of the ticket to merge this ticket into", interaction.OptionTypeInteger, i18n.MessageMergeInvalidTarget, c.AutoCompleteHandler),
+		),
+		Timeout: constants.TimeoutCloseTicket,
+	}
+}
+
+func (c MergeCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (MergeCommand) Execute(ctx registry.CommandContext, ticketId int) {
+	logic.MergeTicket(ctx, ctx, ticketId)
+}
+
+// AutoCompleteHandler suggests the other open tickets of the current ticket's opener
+func (MergeCommand) AutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	if data.GuildId.Value == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+
+	source, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, data.ChannelId, data.GuildId.Value)
+	if err != nil {
+		sentry.Error(err)
+		return nil
+	}
+
+	if source.Id == 0 {
+		return nil
+	}
+
+	tickets, err := dbclient.Client.Tickets.GetOpenByUser(ctx, data.GuildId.Value, source.UserId)
+	if err != nil {
+		sentry.Error(err)
+		return nil
+	}
+
+	choices := make([]interaction.ApplicationCommandOptionChoice, 0, 25)
+	for _, ticket := range tickets {
+		if len(choices) >= 25 {
+			break
+		}
+
+		if ticket.Id == source.Id || !strings.HasPrefix(strconv.Itoa(ticket.Id), value) {
+			continue
+		}
+
+		choices = append(choices, interaction.ApplicationCommandOptionChoice{
+			Name:  strconv.Itoa(ticket.Id),
+			Value: ticket.Id,
+		})
+	}
+
+	return choices
+}
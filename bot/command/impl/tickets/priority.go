@@ -0,0 +1,78 @@
+package tickets
+
+import (
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type PriorityCommand struct {
+}
+
+func (c PriorityCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "priority",
+		Description:     i18n.HelpPriority,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("level", "Priority to give the ticket", interaction.OptionTypeString, i18n.MessagePriorityInvalid, c.AutoCompleteHandler),
+		),
+		Timeout: time.Second * 8,
+	}
+}
+
+func (c PriorityCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (PriorityCommand) Execute(ctx registry.CommandContext, level string) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ticket.Id == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	priority, ok := logic.ParsePriority(level)
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePriorityInvalid)
+		return
+	}
+
+	if err := logic.ChangeTicketPriority(ctx, ctx, ticket, priority); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.ReplyPermanent(customisation.Green, i18n.TitlePriority, i18n.MessagePrioritySuccess, ctx.UserId(), logic.PriorityNames[priority])
+}
+
+func (PriorityCommand) AutoCompleteHandler(_ interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	var choices []interaction.ApplicationCommandOptionChoice
+	for _, priority := range storage.Priorities {
+		name := logic.PriorityNames[priority]
+		if value == "" || strings.Contains(strings.ToLower(name), strings.ToLower(value)) {
+			choices = append(choices, interaction.ApplicationCommandOptionChoice{
+				Name:  name,
+				Value: string(priority),
+			})
+		}
+	}
+
+	return choices
+}
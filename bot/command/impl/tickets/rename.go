@@ -91,6 +91,8 @@ func (RenameCommand) Execute(ctx registry.CommandContext, name string) {
 				return "unclaimed"
 			}
 			return "claimed"
+		}).WithCondition(func(user user.User, member member.Member) bool {
+			return claimer != nil
 		}),
 		// %claim_indicator%
 		logic.NewSubstitutor("claim_indicator", false, false, func(user user.User, member member.Member) string {
@@ -195,6 +197,8 @@ func (RenameCommand) Execute(ctx registry.CommandContext, name string) {
 			}
 			return logic.FormatPlainDate(t, format)
 		}),
+		// %form:LABEL% or %form:CUSTOM_ID%
+		logic.NewFormAnswerSubstitutor(ctx, ticket.GuildId, ticket.Id),
 	})
 	if err != nil {
 		ctx.HandleError(err)
@@ -0,0 +1,50 @@
+package tickets
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type SnoozeCommand struct {
+}
+
+func (SnoozeCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "snooze",
+		Description:     i18n.HelpSnooze,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("until", "How long to snooze for (e.g. 3d, 12h) or a UTC date (e.g. 2025-01-31 09:00)", interaction.OptionTypeString, i18n.MessageSnoozeInvalidTime),
+		),
+		Timeout: time.Second * 10,
+	}
+}
+
+func (c SnoozeCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (SnoozeCommand) Execute(ctx registry.CommandContext, until string) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ticket.Id == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	logic.SnoozeTicketWithReply(ctx, ctx, ticket, until)
+}
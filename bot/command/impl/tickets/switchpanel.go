@@ -276,16 +276,17 @@ func (SwitchPanelCommand) Execute(ctx *cmdcontext.SlashCommandContext, panelId i
 		return
 	}
 
-	// Calculate new channel permissions
+	// Calculate new channel permissions, keeping access for the teams the ticket was routed or escalated to
+	ticket.PanelId = &newPanel.PanelId
+
 	var overwrites []channel.PermissionOverwrite
 	if claimer == 0 {
-		overwrites, err = logic.CreateOverwrites(ctx.Context, ctx, ticket.UserId, &newPanel, newPanel.TargetCategory, members...)
+		overwrites, err = logic.CreateTicketOverwrites(ctx.Context, ctx, ticket, &newPanel, newPanel.TargetCategory, members...)
 		if err != nil {
 			ctx.HandleError(err)
 			return
 		}
 	} else {
-		ticket.PanelId = &newPanel.PanelId
 		overwrites, err = logic.GenerateClaimedOverwrites(ctx.Context, ctx.Worker(), ticket, claimer)
 		if err != nil {
 			ctx.HandleError(err)
@@ -296,7 +297,7 @@ func (SwitchPanelCommand) Execute(ctx *cmdcontext.SlashCommandContext, panelId i
 		// so if this is the case, we still need to calculate permissions
 		if overwrites == nil {
 			membersWithClaimer := append(members, claimer)
-			overwrites, err = logic.CreateOverwrites(ctx.Context, ctx, ticket.UserId, &newPanel, newPanel.TargetCategory, membersWithClaimer...)
+			overwrites, err = logic.CreateTicketOverwrites(ctx.Context, ctx, ticket, &newPanel, newPanel.TargetCategory, membersWithClaimer...)
 			if err != nil {
 				ctx.HandleError(err)
 				return
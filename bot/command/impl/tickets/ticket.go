@@ -0,0 +1,34 @@
+package tickets
+
+import (
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type TicketCommand struct {
+}
+
+func (TicketCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "ticket",
+		Description:     i18n.HelpTicket,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Children: []registry.Command{
+			TicketInfoCommand{},
+		},
+		Category:        command.Tickets,
+		InteractionOnly: true,
+	}
+}
+
+func (c TicketCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (TicketCommand) Execute(ctx registry.CommandContext) {
+	// Cannot call parent command
+}
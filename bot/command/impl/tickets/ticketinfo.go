@@ -0,0 +1,247 @@
+package tickets
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/channel/embed"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction/component"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+	"github.com/TicketsBot-cloud/worker/i18n"
+	"golang.org/x/sync/errgroup"
+)
+
+type TicketInfoCommand struct {
+}
+
+func (TicketInfoCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:             "info",
+		Description:      i18n.HelpTicketInfo,
+		Type:             interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel:  permission.Support,
+		Category:         command.Tickets,
+		InteractionOnly:  true,
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 10,
+	}
+}
+
+func (c TicketInfoCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+const (
+	maxInfoParticipants = 25
+
+	// Answers to multi-step forms could otherwise be longer than a text display can hold
+	maxInfoFormAnswersLength = 2000
+)
+
+func (TicketInfoCommand) Execute(ctx registry.CommandContext) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ticket.Id == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	group, _ := errgroup.WithContext(ctx)
+
+	var panel database.Panel
+	if ticket.PanelId != nil {
+		group.Go(func() (err error) {
+			panel, err = dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
+			return
+		})
+	}
+
+	var claimer uint64
+	group.Go(func() (err error) {
+		claimer, err = dbclient.Client.TicketClaims.Get(ctx, ticket.GuildId, ticket.Id)
+		return
+	})
+
+	var labels map[int]string
+	group.Go(func() (err error) {
+		labels, err = dbclient.Client.TicketLabelAssignments.GetLabelNameByTicket(ctx, ticket.GuildId, ticket.Id)
+		return
+	})
+
+	var responseTime *time.Duration
+	group.Go(func() (err error) {
+		responseTime, err = dbclient.Storage.FirstResponseTime.Get(ctx, ticket.GuildId, ticket.Id)
+		return
+	})
+
+	var participants []uint64
+	group.Go(func() (err error) {
+		participants, err = dbclient.Client.Participants.GetParticipants(ctx, ticket.GuildId, ticket.Id)
+		return
+	})
+
+	var closeRequest database.CloseRequest
+	var hasCloseRequest bool
+	group.Go(func() (err error) {
+		closeRequest, hasCloseRequest, err = dbclient.Client.CloseRequest.Get(ctx, ticket.GuildId, ticket.Id)
+		return
+	})
+
+	var isExcluded bool
+	group.Go(func() (err error) {
+		isExcluded, err = dbclient.Client.AutoCloseExclude.IsExcluded(ctx, ticket.GuildId, ticket.Id)
+		return
+	})
+
+	// Tickets opened before form answers were stored only have them in the welcome message. If the message has been
+	// deleted, we just omit them.
+	var formAnswers storage.TicketFormAnswers
+	var welcomeMessageFields []*embed.EmbedField
+	group.Go(func() (err error) {
+		formAnswers, err = dbclient.Storage.TicketFormAnswers.Get(ctx, ticket.GuildId, ticket.Id)
+		if err != nil || len(formAnswers) > 0 || ticket.ChannelId == nil || ticket.WelcomeMessageId == nil {
+			return
+		}
+
+		msg, err := ctx.Worker().GetChannelMessage(*ticket.ChannelId, *ticket.WelcomeMessageId)
+		if err == nil && len(msg.Embeds) > 1 {
+			for _, e := range msg.Embeds[1:] {
+				welcomeMessageFields = append(welcomeMessageFields, e.Fields...)
+			}
+		}
+
+		return nil
+	})
+
+	if err := group.Wait(); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	none := ctx.GetMessage(i18n.MessageTicketInfoNone)
+
+	panelTitle := none
+	if panel.PanelId != 0 {
+		panelTitle = panel.Title
+	}
+
+	claimedBy := none
+	if claimer != 0 {
+		claimedBy = fmt.Sprintf("<@%d>", claimer)
+	}
+
+	labelNames := none
+	if len(labels) > 0 {
+		names := make([]string, 0, len(labels))
+		for _, name := range labels {
+			names = append(names, fmt.Sprintf("`%s`", name))
+		}
+
+		sort.Strings(names)
+		labelNames = strings.Join(names, ", ")
+	}
+
+	firstResponse := ctx.GetMessage(i18n.MessageTicketInfoAwaitingResponse)
+	if responseTime != nil {
+		firstResponse = utils.FormatTime(*responseTime)
+	}
+
+	participantMentions := none
+	if len(participants) > 0 {
+		mentions := make([]string, 0, min(len(participants), maxInfoParticipants))
+		for _, userId := range participants[:min(len(participants), maxInfoParticipants)] {
+			mentions = append(mentions, fmt.Sprintf("<@%d>", userId))
+		}
+
+		participantMentions = strings.Join(mentions, ", ")
+		if len(participants) > maxInfoParticipants {
+			participantMentions += fmt.Sprintf(" (+%d)", len(participants)-maxInfoParticipants)
+		}
+	}
+
+	closeRequestInfo := none
+	if hasCloseRequest {
+		closeRequestInfo = fmt.Sprintf("<@%d>", closeRequest.UserId)
+		if closeRequest.CloseAt != nil {
+			closeRequestInfo += " " + ctx.GetMessage(i18n.MessageTicketInfoCloseRequestAt, closeRequest.CloseAt.Unix())
+		}
+
+		if closeRequest.Reason != nil {
+			closeRequestInfo += fmt.Sprintf("\n> %s", utils.EscapeMarkdown(*closeRequest.Reason))
+		}
+	}
+
+	excluded := ctx.GetMessage(i18n.MessageTicketInfoNo)
+	if isExcluded {
+		excluded = ctx.GetMessage(i18n.MessageTicketInfoYes)
+	}
+
+	details := []string{
+		ctx.GetMessage(i18n.MessageTicketInfoOpenedBy, ticket.UserId),
+		ctx.GetMessage(i18n.MessageTicketInfoPanel, panelTitle),
+		ctx.GetMessage(i18n.MessageTicketInfoOpenedAt, ticket.OpenTime.Unix(), ticket.OpenTime.Unix()),
+		ctx.GetMessage(i18n.MessageTicketInfoStatus, strings.ToLower(string(ticket.Status))),
+		ctx.GetMessage(i18n.MessageTicketInfoClaimedBy, claimedBy),
+		ctx.GetMessage(i18n.MessageTicketInfoLabels, labelNames),
+	}
+
+	activity := []string{
+		ctx.GetMessage(i18n.MessageTicketInfoFirstResponse, firstResponse),
+		ctx.GetMessage(i18n.MessageTicketInfoParticipants, participantMentions),
+		ctx.GetMessage(i18n.MessageTicketInfoCloseRequest, closeRequestInfo),
+		ctx.GetMessage(i18n.MessageTicketInfoAutoCloseExcluded, excluded),
+	}
+
+	innerComponents := []component.Component{
+		component.BuildTextDisplay(component.TextDisplay{Content: strings.Join(details, "\n")}),
+		component.BuildSeparator(component.Separator{Divider: utils.Ptr(true), Spacing: utils.Ptr(1)}),
+		component.BuildTextDisplay(component.TextDisplay{Content: strings.Join(activity, "\n")}),
+	}
+
+	if len(formAnswers) > 0 || len(welcomeMessageFields) > 0 {
+		var content strings.Builder
+		content.WriteString(fmt.Sprintf("**%s**", ctx.GetMessage(i18n.MessageTicketInfoFormAnswers)))
+		for _, answer := range formAnswers {
+			value := none
+			if answer.Answer != "" {
+				value = utils.EscapeMarkdown(answer.Answer)
+			}
+
+			content.WriteString(fmt.Sprintf("\n**%s**\n%s", utils.EscapeMarkdown(answer.Label), value))
+		}
+
+		// The welcome message's values are already escaped
+		for _, field := range welcomeMessageFields {
+			content.WriteString(fmt.Sprintf("\n**%s**\n%s", utils.EscapeMarkdown(field.Name), field.Value))
+		}
+
+		formAnswersContent := content.String()
+		if runes := []rune(formAnswersContent); len(runes) > maxInfoFormAnswersLength {
+			formAnswersContent = string(runes[:maxInfoFormAnswersLength-3]) + "..."
+		}
+
+		innerComponents = append(innerComponents,
+			component.BuildSeparator(component.Separator{Divider: utils.Ptr(true), Spacing: utils.Ptr(1)}),
+			component.BuildTextDisplay(component.TextDisplay{Content: formAnswersContent}),
+		)
+	}
+
+	title := ctx.GetMessage(i18n.MessageTicketInfoTitle, ticket.Id)
+	container := utils.BuildContainerWithComponents(ctx, customisation.Green, title, innerComponents)
+
+	_, _ = ctx.ReplyWith(command.NewEphemeralMessageResponseWithComponents(utils.Slice(container)))
+}
@@ -0,0 +1,34 @@
+package tickets
+
+import (
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type TicketsCommand struct {
+}
+
+func (TicketsCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "tickets",
+		Description:     i18n.HelpTickets,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Children: []registry.Command{
+			TicketsListCommand{},
+		},
+		Category:        command.Tickets,
+		InteractionOnly: true,
+	}
+}
+
+func (c TicketsCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (TicketsCommand) Execute(ctx registry.CommandContext) {
+	// Cannot call parent command
+}
@@ -0,0 +1,115 @@
+package tickets
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/common/sentry"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction/component"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type TicketsListCommand struct {
+}
+
+func (c TicketsListCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "list",
+		Description:     i18n.HelpTicketsList,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		InteractionOnly: true,
+		Arguments: command.Arguments(
+			command.NewOptionalArgument("mine", "Only show tickets claimed by you", interaction.OptionTypeBoolean, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("unclaimed", "Only show tickets that have not been claimed", interaction.OptionTypeBoolean, i18n.MessageInvalidArgument),
+			command.NewOptionalAutocompleteableArgument("panel", "Only show tickets opened from this panel", interaction.OptionTypeInteger, i18n.MessageSwitchPanelInvalidPanel, c.PanelAutoCompleteHandler),
+			command.NewOptionalArgument("user", "Only show tickets opened by this user", interaction.OptionTypeUser, i18n.MessageInvalidUser),
+			command.NewOptionalAutocompleteableArgument("label", "Only show tickets with this label", interaction.OptionTypeInteger, i18n.MessageInvalidArgument, c.LabelAutoCompleteHandler),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 10,
+	}
+}
+
+func (c TicketsListCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (TicketsListCommand) Execute(ctx registry.CommandContext, mine, unclaimed *bool, panelId *int, userId *uint64, labelId *int) {
+	var filter logic.TicketListFilter
+	if mine != nil {
+		filter.Mine = *mine
+	}
+
+	if unclaimed != nil {
+		filter.Unclaimed = *unclaimed
+	}
+
+	if panelId != nil {
+		filter.PanelId = *panelId
+	}
+
+	if userId != nil {
+		filter.UserId = *userId
+	}
+
+	if labelId != nil {
+		filter.LabelId = *labelId
+	}
+
+	comp, page, totalPages, err := logic.BuildTicketListMessage(ctx, ctx, filter, 0)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	_, _ = ctx.ReplyWith(command.NewEphemeralMessageResponseWithComponents([]component.Component{
+		comp,
+		logic.BuildTicketListButtons(filter, page, totalPages),
+	}))
+}
+
+func (TicketsListCommand) PanelAutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	return SwitchPanelCommand{}.AutoCompleteHandler(data, value)
+}
+
+func (TicketsListCommand) LabelAutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	if data.GuildId.Value == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
+	defer cancel()
+
+	labels, err := dbclient.Client.TicketLabels.GetByGuild(ctx, data.GuildId.Value)
+	if err != nil {
+		sentry.Error(err) // TODO: Context
+		return nil
+	}
+
+	choices := make([]interaction.ApplicationCommandOptionChoice, 0, 25)
+	for _, label := range labels {
+		if value != "" && !strings.Contains(strings.ToLower(label.Name), strings.ToLower(value)) {
+			continue
+		}
+
+		choices = append(choices, interaction.ApplicationCommandOptionChoice{
+			Name:  label.Name,
+			Value: label.LabelId,
+		})
+
+		if len(choices) == 25 {
+			break
+		}
+	}
+
+	return choices
+}
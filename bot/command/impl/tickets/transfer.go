@@ -1,6 +1,7 @@
 package tickets
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/TicketsBot-cloud/common/permission"
@@ -74,6 +75,12 @@ func (TransferCommand) Execute(ctx registry.CommandContext, userId uint64) {
 	}
 
 	if err := logic.ClaimTicket(ctx, ctx, ticket, userId); err != nil {
+		var limitErr *logic.ClaimLimitError
+		if errors.As(err, &limitErr) {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageTransferClaimLimitReached, fmt.Sprintf("<@%d>", userId), limitErr.Limit)
+			return
+		}
+
 		ctx.HandleError(err)
 		return
 	}
@@ -108,7 +108,7 @@ func (UnclaimCommand) Execute(ctx *context.SlashCommandContext) {
 		return
 	}
 
-	overwrites, err := logic.CreateOverwrites(ctx.Context, ctx, ticket.UserId, panel, ch.ParentId.Value)
+	overwrites, err := logic.CreateTicketOverwrites(ctx.Context, ctx, ticket, panel, ch.ParentId.Value)
 	if err != nil {
 		ctx.HandleError(err)
 		return
@@ -0,0 +1,70 @@
+package tickets
+
+import (
+	"time"
+
+	"github.com/TicketsBot-cloud/common/permission"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+type UnsnoozeCommand struct {
+}
+
+func (UnsnoozeCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:             "unsnooze",
+		Description:      i18n.HelpUnsnooze,
+		Type:             interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel:  permission.Support,
+		Category:         command.Tickets,
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 10,
+	}
+}
+
+func (c UnsnoozeCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (UnsnoozeCommand) Execute(ctx registry.CommandContext) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ticket.Id == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	hasPermission, err := logic.HasPermissionForTicket(ctx, ctx.Worker(), ticket, ctx.UserId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !hasPermission {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageSnoozeNoPermission)
+		return
+	}
+
+	unsnoozed, err := logic.UnsnoozeTicket(ctx, ctx.Worker(), ticket, ctx.PremiumTier())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !unsnoozed {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageUnsnoozeNotSnoozed)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSnooze, i18n.MessageUnsnoozeSuccess)
+}
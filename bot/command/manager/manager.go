@@ -37,8 +37,11 @@ func (cm *CommandManager) RegisterCommands() {
 
 	cm.registry["addadmin"] = settings.AddAdminCommand{}
 	cm.registry["addsupport"] = settings.AddSupportCommand{}
+	cm.registry["autoassign"] = settings.AutoAssignCommand{}
 	cm.registry["autoclose"] = settings.AutoCloseCommand{}
 	cm.registry["blacklist"] = settings.BlacklistCommand{}
+	cm.registry["claimlimit"] = settings.ClaimLimitCommand{}
+	cm.registry["escalation"] = settings.EscalationCommand{}
 	cm.registry["language"] = settings.LanguageCommand{}
 	cm.registry["panel"] = settings.PanelCommand{}
 	cm.registry["premium"] = settings.PremiumCommand{}
@@ -46,6 +49,12 @@ func (cm *CommandManager) RegisterCommands() {
 	cm.registry["removesupport"] = settings.RemoveSupportCommand{}
 	cm.registry["premium"] = settings.PremiumCommand{}
 	cm.registry["setup"] = setup.SetupCommand{}
+	cm.registry["sla"] = settings.SlaCommand{}
+	cm.registry["snoozesettings"] = settings.SnoozeSettingsCommand{}
+	cm.registry["prioritysettings"] = settings.PrioritySettingsCommand{}
+	cm.registry["formvalidation"] = settings.FormValidationCommand{}
+	cm.registry["formflow"] = settings.FormFlowCommand{}
+	cm.registry["routing"] = settings.RoutingCommand{}
 	cm.registry["viewstaff"] = settings.ViewStaffCommand{}
 
 	cm.registry["stats"] = statistics.StatsCommand{}
@@ -58,6 +67,9 @@ func (cm *CommandManager) RegisterCommands() {
 	cm.registry["close"] = tickets.CloseCommand{}
 	cm.registry["edit"] = tickets.EditCommand{}
 	cm.registry["closerequest"] = tickets.CloseRequestCommand{}
+	cm.registry["escalate"] = tickets.EscalateCommand{}
+	cm.registry["merge"] = tickets.MergeCommand{}
+	cm.registry["priority"] = tickets.PriorityCommand{}
 	cm.registry["notes"] = tickets.NotesCommand{}
 	cm.registry["on-call"] = tickets.OnCallCommand{}
 	cm.registry["open"] = tickets.OpenCommand{}
@@ -65,9 +77,13 @@ func (cm *CommandManager) RegisterCommands() {
 	cm.registry["remove"] = tickets.RemoveCommand{}
 	cm.registry["rename"] = tickets.RenameCommand{}
 	cm.registry["reopen"] = tickets.ReopenCommand{}
+	cm.registry["snooze"] = tickets.SnoozeCommand{}
 	cm.registry["switchpanel"] = tickets.SwitchPanelCommand{}
+	cm.registry["ticket"] = tickets.TicketCommand{}
+	cm.registry["tickets"] = tickets.TicketsCommand{}
 	cm.registry["transfer"] = tickets.TransferCommand{}
 	cm.registry["unclaim"] = tickets.UnclaimCommand{}
+	cm.registry["unsnooze"] = tickets.UnsnoozeCommand{}
 }
 
 func (cm *CommandManager) RunSetupFuncs() {
@@ -5,13 +5,18 @@ import (
 	"fmt"
 
 	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
 	"github.com/TicketsBot-cloud/worker/config"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"go.uber.org/zap"
 )
 
-var Client *database.Database
+var (
+	Client  *database.Database
+	Storage *storage.Database
+	pool    *pgxpool.Pool
+)
 
 func Connect(logger *zap.Logger) {
 	cfg, err := pgxpool.ParseConfig(fmt.Sprintf(
@@ -32,11 +37,18 @@ func Connect(logger *zap.Logger) {
 	cfg.ConnConfig.LogLevel = pgx.LogLevelWarn
 	cfg.ConnConfig.Logger = NewLogAdapter(logger)
 
-	pool, err := pgxpool.ConnectConfig(context.Background(), cfg)
+	pool, err = pgxpool.ConnectConfig(context.Background(), cfg)
 	if err != nil {
 		logger.Fatal("Failed to connect to database", zap.Error(err))
 		return
 	}
 
 	Client = database.NewDatabase(pool)
+
+	Storage = storage.NewDatabase(pool)
+}
+
+// Ping checks that a connection to the database can be acquired and used
+func Ping(ctx context.Context) error {
+	return pool.Ping(ctx)
 }
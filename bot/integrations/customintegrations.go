@@ -12,6 +12,7 @@ import (
 
 	"github.com/TicketsBot-cloud/database"
 	"github.com/TicketsBot-cloud/worker/bot/metrics/prometheus"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
 	"github.com/TicketsBot-cloud/worker/bot/utils"
 	"github.com/TicketsBot-cloud/worker/config"
 )
@@ -45,15 +46,21 @@ func Fetch(
 	secrets []database.SecretWithValue,
 	headers []database.CustomIntegrationHeader,
 	placeholders []database.CustomIntegrationPlaceholder, // Only include placeholders that are actually used
-	formAnswers formAnswers,
+	formAnswers storage.TicketFormAnswers,
 ) (map[string]string, error) {
 	prometheus.LogIntegrationRequest(integration, ticket.GuildId)
 
+	// Public integrations are not given the user's form answers
+	if integration.Public {
+		formAnswers = nil
+	}
+
 	url := strings.ReplaceAll(integration.WebhookUrl, "%user_id%", strconv.FormatUint(ticket.UserId, 10))
 	url = strings.ReplaceAll(url, "%guild_id%", strconv.FormatUint(ticket.GuildId, 10))
 	for _, secret := range secrets {
 		url = strings.ReplaceAll(url, "%"+secret.Name+"%", secret.Value)
 	}
+	url = substituteUrlFormPlaceholders(url, formAnswers)
 
 	// Apply headers
 	headerMap := make(map[string]string)
@@ -68,6 +75,7 @@ func Fetch(
 		for _, secret := range secrets {
 			value = strings.ReplaceAll(value, "%"+secret.Name+"%", secret.Value)
 		}
+		value = substituteHeaderFormPlaceholders(value, formAnswers)
 
 		headerMap[header.Name] = value
 	}
@@ -83,7 +91,7 @@ func Fetch(
 		}
 
 		if !integration.Public {
-			postBody.FormData = formAnswers
+			postBody.FormData = newFormAnswers(formAnswers)
 		}
 
 		body = postBody
@@ -0,0 +1,69 @@
+package integrations
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+)
+
+// formPlaceholderRegex matches %form:<input label or custom id>%
+var formPlaceholderRegex = regexp.MustCompile(`%form:([^%]+)%`)
+
+func newFormAnswers(answers storage.TicketFormAnswers) formAnswers {
+	if len(answers) == 0 {
+		return nil
+	}
+
+	m := make(formAnswers, len(answers))
+	for _, answer := range answers {
+		value := answer.Answer
+		m[answer.Label] = &value
+	}
+
+	return m
+}
+
+// substituteUrlFormPlaceholders replaces form placeholders in a webhook URL, escaping the answers so that they can't
+// change the structure of the URL. Answers in the query string are escaped as query values, and any before it as path
+// segments, as a space is only encoded as + in a query.
+func substituteUrlFormPlaceholders(s string, answers storage.TicketFormAnswers) string {
+	// Placeholders are blanked out when finding the query, as a label may contain a question mark
+	queryStart := strings.IndexByte(formPlaceholderRegex.ReplaceAllStringFunc(s, func(match string) string {
+		return strings.Repeat("%", len(match))
+	}), '?')
+
+	var b strings.Builder
+	last := 0
+	for _, match := range formPlaceholderRegex.FindAllStringSubmatchIndex(s, -1) {
+		answer, _ := answers.Get(s[match[2]:match[3]])
+
+		b.WriteString(s[last:match[0]])
+		if queryStart != -1 && match[0] > queryStart {
+			b.WriteString(url.QueryEscape(answer))
+		} else {
+			b.WriteString(url.PathEscape(answer))
+		}
+
+		last = match[1]
+	}
+
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// substituteHeaderFormPlaceholders replaces form placeholders in a header value, removing line breaks so that answers
+// can't inject additional headers
+func substituteHeaderFormPlaceholders(s string, answers storage.TicketFormAnswers) string {
+	return substituteFormPlaceholders(s, answers, func(answer string) string {
+		return strings.NewReplacer("\r", "", "\n", " ").Replace(answer)
+	})
+}
+
+func substituteFormPlaceholders(s string, answers storage.TicketFormAnswers, escape func(string) string) string {
+	return formPlaceholderRegex.ReplaceAllStringFunc(s, func(match string) string {
+		answer, _ := answers.Get(formPlaceholderRegex.FindStringSubmatch(match)[1])
+		return escape(answer)
+	})
+}
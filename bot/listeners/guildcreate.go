@@ -2,6 +2,7 @@ package listeners
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -21,7 +22,7 @@ import (
 )
 
 // Fires when we receive a guild
-func OnGuildCreate(worker *worker.Context, e events.GuildCreate) {
+func OnGuildCreate(worker *worker.Context, e events.GuildCreate) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*6) // TODO: Propagate context
 	defer cancel()
 
@@ -31,9 +32,11 @@ func OnGuildCreate(worker *worker.Context, e events.GuildCreate) {
 			sentry.Error(err)
 		}
 
-		return
+		return nil
 	}
 
+	var errs []error
+
 	if time.Now().Sub(e.JoinedAt) < time.Minute {
 		statsd.Client.IncrementKey(statsd.KeyJoins)
 
@@ -45,12 +48,12 @@ func OnGuildCreate(worker *worker.Context, e events.GuildCreate) {
 		}
 
 		if err := dbclient.Client.GuildLeaveTime.Delete(ctx, e.Guild.Id); err != nil {
-			sentry.Error(err)
+			errs = append(errs, err)
 		}
 
 		// Add guild owner as ticket admin
 		if err := dbclient.Client.Permissions.AddAdmin(ctx, e.Guild.Id, e.Guild.OwnerId); err != nil {
-			sentry.Error(err)
+			errs = append(errs, err)
 		}
 
 		// Add roles with Administrator permission as bot admins by default
@@ -62,11 +65,13 @@ func OnGuildCreate(worker *worker.Context, e events.GuildCreate) {
 
 			if permission.HasPermissionRaw(role.Permissions, permission.Administrator) {
 				if err := dbclient.Client.RolePermissions.AddAdmin(ctx, e.Guild.Id, role.Id); err != nil { // TODO: Bulk
-					sentry.Error(err)
+					errs = append(errs, err)
 				}
 			}
 		}
 	}
+
+	return errors.Join(errs...)
 }
 
 func sendIntroMessage(ctx context.Context, worker *worker.Context, guild guild.Guild, userId uint64) {
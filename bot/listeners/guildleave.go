@@ -2,6 +2,7 @@ package listeners
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/TicketsBot-cloud/common/sentry"
@@ -16,29 +17,33 @@ import (
  * The inner payload is an unavailable guild object.
  * If the unavailable field is not set, the user was removed from the guild.
  */
-func OnGuildLeave(worker *worker.Context, e events.GuildDelete) {
+func OnGuildLeave(worker *worker.Context, e events.GuildDelete) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
 	defer cancel()
 
 	span := sentry.StartSpan(ctx, "OnGuildLeave")
 	defer span.Finish()
 
+	var errs []error
+
 	if e.Unavailable == nil {
 		statsd.Client.IncrementKey(statsd.KeyLeaves)
 
 		if worker.IsWhitelabel {
 			if err := dbclient.Client.WhitelabelGuilds.Delete(ctx, worker.BotId, e.Guild.Id); err != nil {
-				sentry.Error(err)
+				errs = append(errs, err)
 			}
 		}
 
 		// Exclude from autoclose
 		if err := dbclient.Client.AutoCloseExclude.ExcludeAll(ctx, e.Guild.Id); err != nil {
-			sentry.Error(err)
+			errs = append(errs, err)
 		}
 
 		if err := dbclient.Client.GuildLeaveTime.Set(ctx, e.Guild.Id); err != nil {
-			sentry.Error(err)
+			errs = append(errs, err)
 		}
 	}
+
+	return errors.Join(errs...)
 }
@@ -12,7 +12,7 @@ import (
 	"github.com/TicketsBot-cloud/worker/bot/dbclient"
 )
 
-func OnGuildUpdate(worker *worker.Context, e events.GuildUpdate) {
+func OnGuildUpdate(worker *worker.Context, e events.GuildUpdate) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
 
@@ -24,29 +24,32 @@ func OnGuildUpdate(worker *worker.Context, e events.GuildUpdate) {
 	if err != nil {
 		if errors.Is(err, cache.ErrNotFound) {
 			// If we don't have a cached owner, just add the current one as admin
-			if err := dbclient.Client.Permissions.AddAdmin(ctx, e.Guild.Id, e.Guild.OwnerId); err != nil {
-				sentry.Error(err)
-			}
-			return
+			return dbclient.Client.Permissions.AddAdmin(ctx, e.Guild.Id, e.Guild.OwnerId)
 		}
-		sentry.Error(err)
-		return
+
+		return err
 	}
 
 	// Check if ownership changed
 	if oldOwnerId != e.Guild.OwnerId {
+		var errs []error
+
 		// Add new owner as ticket admin
 		if err := dbclient.Client.Permissions.AddAdmin(ctx, e.Guild.Id, e.Guild.OwnerId); err != nil {
-			sentry.Error(err)
+			errs = append(errs, err)
 		}
 
 		// Remove old owner as ticket admin and support
 		// Note: They may still have admin/support access through their roles with Administrator permission
 		if err := dbclient.Client.Permissions.RemoveAdmin(ctx, e.Guild.Id, oldOwnerId); err != nil {
-			sentry.Error(err)
+			errs = append(errs, err)
 		}
 		if err := dbclient.Client.Permissions.RemoveSupport(ctx, e.Guild.Id, oldOwnerId); err != nil {
-			sentry.Error(err)
+			errs = append(errs, err)
 		}
+
+		return errors.Join(errs...)
 	}
+
+	return nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/TicketsBot-cloud/gdl/objects/channel/message"
 	"github.com/TicketsBot-cloud/worker"
 	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
 	"github.com/TicketsBot-cloud/worker/bot/metrics/prometheus"
 	"github.com/TicketsBot-cloud/worker/bot/metrics/statsd"
 	"github.com/TicketsBot-cloud/worker/bot/redis"
@@ -22,7 +23,7 @@ import (
 )
 
 // proxy messages to web UI + set last message id
-func OnMessage(worker *worker.Context, e events.MessageCreate) {
+func OnMessage(worker *worker.Context, e events.MessageCreate) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*7) // TODO: Propagate context
 	defer cancel()
 
@@ -37,7 +38,7 @@ func OnMessage(worker *worker.Context, e events.MessageCreate) {
 
 	// ignore DMs
 	if e.GuildId == 0 {
-		return
+		return nil
 	}
 
 	// Delete pin notification messages in ticket channels.
@@ -50,20 +51,22 @@ func OnMessage(worker *worker.Context, e events.MessageCreate) {
 				}
 			})
 		}
-		return
+		return nil
 	}
 
 	ticket, isTicket, err := getTicket(span.Context(), e.ChannelId)
 	if err != nil {
-		sentry.ErrorWithContext(err, utils.MessageCreateErrorContext(e))
-		return
+		return err
 	}
 
 	// ensure valid ticket channel
 	if !isTicket || ticket.Id == 0 {
-		return
+		return nil
 	}
 
+	// Errors from updating the ticket's state are returned, so that the event can be retried
+	var errs []error
+
 	var isStaffCached *bool
 
 	// ignore our own messages
@@ -71,17 +74,33 @@ func OnMessage(worker *worker.Context, e events.MessageCreate) {
 		// set participants, for logging
 		sentry.WithSpan0(span.Context(), "Add participant", func(span *sentry.Span) {
 			if err := dbclient.Client.Participants.Set(ctx, e.GuildId, ticket.Id, e.Author.Id); err != nil {
-				sentry.ErrorWithContext(err, utils.MessageCreateErrorContext(e))
+				errs = append(errs, err)
 			}
 		})
 
+		// any reply during the autoclose warning period keeps the ticket open
+		sentry.WithSpan0(span.Context(), "Cancel pending autoclose", func(span *sentry.Span) {
+			if _, err := logic.CancelAutoClose(ctx, e.GuildId, ticket.Id); err != nil {
+				errs = append(errs, err)
+			}
+		})
+
+		// the opener replying during the countdown keeps the ticket open
+		if e.Author.Id == ticket.UserId {
+			sentry.WithSpan0(span.Context(), "Cancel scheduled close", func(span *sentry.Span) {
+				if err := logic.CancelScheduledCloseOnReply(ctx, worker, ticket); err != nil {
+					errs = append(errs, err)
+				}
+			})
+		}
+
 		isStaffCached, err = sentry.WithSpan2(span.Context(), "Update ticket last activity", func(span *sentry.Span) (*bool, error) {
 			v, err := isStaff(ctx, e, ticket)
 			return &v, err
 		})
 
 		if err != nil {
-			sentry.ErrorWithContext(err, utils.MessageCreateErrorContext(e))
+			errs = append(errs, err)
 		} else {
 			// set ticket last message, for autoclose
 			// isStaffCached cannot be nil at this point
@@ -89,13 +108,25 @@ func OnMessage(worker *worker.Context, e events.MessageCreate) {
 			updateCtx, updateCancel := context.WithTimeout(context.Background(), time.Second*3)
 			defer updateCancel()
 			if err := updateLastMessage(updateCtx, e, ticket, *isStaffCached); err != nil {
-				sentry.ErrorWithContext(err, utils.MessageCreateErrorContext(e))
+				errs = append(errs, err)
 			}
 
 			if *isStaffCached { // check the user is staff
 				// We don't have to check for previous responses due to ON CONFLICT DO NOTHING
 				sentry.WithSpan0(span.Context(), "Set first response time", func(span *sentry.Span) {
 					if err := dbclient.Client.FirstResponseTime.Set(ctx, e.GuildId, e.Author.Id, ticket.Id, time.Now().Sub(ticket.OpenTime)); err != nil {
+						errs = append(errs, err)
+					}
+				})
+
+				sentry.WithSpan0(span.Context(), "Stop first response SLA timers", func(span *sentry.Span) {
+					if err := logic.OnSlaFirstResponse(ctx, e.GuildId, ticket.Id, e.Author.Id); err != nil {
+						sentry.ErrorWithContext(err, utils.MessageCreateErrorContext(e))
+					}
+				})
+
+				sentry.WithSpan0(span.Context(), "Stop escalation timer", func(span *sentry.Span) {
+					if err := logic.StopEscalationTimer(ctx, e.GuildId, ticket.Id); err != nil {
 						sentry.ErrorWithContext(err, utils.MessageCreateErrorContext(e))
 					}
 				})
@@ -107,23 +138,25 @@ func OnMessage(worker *worker.Context, e events.MessageCreate) {
 		return utils.PremiumClient.GetTierByGuildId(ctx, e.GuildId, true, worker.Token, worker.RateLimiter)
 	})
 	if err != nil {
-		sentry.ErrorWithContext(err, utils.MessageCreateErrorContext(e))
-		return
+		return errors.Join(append(errs, err)...)
 	}
 
 	// proxy msg to web UI
 	if premiumTier > premium.None {
-		if err := sentry.WithSpan1(span.Context(), "Relay message to dashboard", func(span *sentry.Span) error {
-			data := chatrelay.MessageData{
-				Ticket:  ticket,
-				Message: e.Message,
-			}
+		// Don't relay the message to the dashboard a second time if the event was redelivered
+		if !worker.Redelivered {
+			if err := sentry.WithSpan1(span.Context(), "Relay message to dashboard", func(span *sentry.Span) error {
+				data := chatrelay.MessageData{
+					Ticket:  ticket,
+					Message: e.Message,
+				}
 
-			prometheus.ForwardedDashboardMessages.Inc()
+				prometheus.ForwardedDashboardMessages.Inc()
 
-			return chatrelay.PublishMessage(redis.Client, data)
-		}); err != nil {
-			sentry.ErrorWithContext(err, utils.MessageCreateErrorContext(e))
+				return chatrelay.PublishMessage(redis.Client, data)
+			}); err != nil {
+				sentry.ErrorWithContext(err, utils.MessageCreateErrorContext(e))
+			}
 		}
 
 		// Ignore the welcome message and ping message
@@ -134,8 +167,7 @@ func OnMessage(worker *worker.Context, e events.MessageCreate) {
 			} else {
 				tmp, err := isStaff(ctx, e, ticket)
 				if err != nil {
-					sentry.ErrorWithContext(err, utils.MessageCreateErrorContext(e))
-					return
+					return errors.Join(append(errs, err)...)
 				}
 
 				userIsStaff = tmp
@@ -150,19 +182,21 @@ func OnMessage(worker *worker.Context, e events.MessageCreate) {
 
 			if ticket.Status != newStatus {
 				if err := dbclient.Client.Tickets.SetStatus(ctx, e.GuildId, ticket.Id, newStatus); err != nil {
-					sentry.ErrorWithContext(err, utils.MessageCreateErrorContext(e))
+					errs = append(errs, err)
 				}
 
 				if !ticket.IsThread {
 					if err := sentry.WithSpan1(span.Context(), "Update status update queue", func(span *sentry.Span) error {
 						return dbclient.Client.CategoryUpdateQueue.Add(ctx, e.GuildId, ticket.Id, newStatus)
 					}); err != nil {
-						sentry.ErrorWithContext(err, utils.MessageCreateErrorContext(e))
+						errs = append(errs, err)
 					}
 				}
 			}
 		}
 	}
+
+	return errors.Join(errs...)
 }
 
 func updateLastMessage(ctx context.Context, msg events.MessageCreate, ticket database.Ticket, isStaff bool) error {
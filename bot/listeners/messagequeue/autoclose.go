@@ -2,6 +2,7 @@ package messagequeue
 
 import (
 	"context"
+	"sync"
 
 	"github.com/TicketsBot-cloud/common/autoclose"
 	"github.com/TicketsBot-cloud/common/sentry"
@@ -19,15 +20,25 @@ import (
 
 const AutoCloseReason = "Automatically closed due to inactivity"
 
-func ListenAutoClose(logger *zap.Logger) {
+// autoCloseQueue must match the list that common/autoclose publishes to
+const autoCloseQueue = "tickets:autoclose"
+
+// ListenAutoClose processes autoclose events until ctx is cancelled, then waits for in-flight events to finish
+func ListenAutoClose(ctx context.Context, logger *zap.Logger) {
 	ch := make(chan autoclose.Ticket)
-	go autoclose.Listen(redis.Client, ch)
+	go redis.ListenQueue(ctx, autoCloseQueue, ch)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
 
 	for acTicket := range ch {
 		statsd.Client.IncrementKey(statsd.AutoClose)
 
 		acTicket := acTicket
+		wg.Add(1)
 		go func() {
+			defer wg.Done()
+
 			ctx, cancel := context.WithTimeout(context.Background(), constants.TimeoutCloseTicket)
 			defer cancel()
 
@@ -48,6 +59,22 @@ func ListenAutoClose(logger *zap.Logger) {
 				return
 			}
 
+			// Snoozed tickets are not autoclosed until they wake up
+			snoozed, err := dbclient.Storage.TicketSnoozes.IsSnoozed(ctx, ticket.GuildId, ticket.Id)
+			if err != nil {
+				logger.Error("Failed to check whether ticket is snoozed for autoclose",
+					zap.Int("ticket_id", acTicket.TicketId),
+					zap.Uint64("guild_id", acTicket.GuildId),
+					zap.Error(err),
+				)
+				sentry.Error(err)
+				return
+			}
+
+			if snoozed {
+				return
+			}
+
 			// get worker
 			worker, err := buildContext(ctx, ticket, cache.Client)
 			if err != nil {
@@ -82,6 +109,27 @@ func ListenAutoClose(logger *zap.Logger) {
 			}
 
 			cc := cmdcontext.NewAutoCloseContext(ctx, worker, ticket.GuildId, *ticket.ChannelId, worker.BotId, premiumTier)
+
+			// If the guild has a warning period, the ticket is closed by a timer instead, unless someone responds first
+			deferred, err := logic.WarnBeforeAutoClose(ctx, cc, ticket)
+			if err != nil {
+				logger.Error("Failed to send autoclose warning",
+					zap.Int("ticket_id", acTicket.TicketId),
+					zap.Uint64("guild_id", acTicket.GuildId),
+					zap.Error(err),
+				)
+				sentry.Error(err)
+				return
+			}
+
+			if deferred {
+				logger.Debug("Deferred autoclose until warning period has passed",
+					zap.Int("ticket_id", acTicket.TicketId),
+					zap.Uint64("guild_id", acTicket.GuildId),
+				)
+				return
+			}
+
 			logic.CloseTicket(ctx, cc, gdlUtils.StrPtr(AutoCloseReason), true)
 
 			logger.Info("Successfully processed autoclose event",
@@ -0,0 +1,62 @@
+package messagequeue
+
+import (
+	"context"
+	"encoding/json"
+
+	gdlUtils "github.com/TicketsBot-cloud/gdl/utils"
+	"github.com/TicketsBot-cloud/worker/bot/cache"
+	cmdcontext "github.com/TicketsBot-cloud/worker/bot/command/context"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/bot/scheduler"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+)
+
+func init() {
+	scheduler.Register(logic.TimerKindAutoClose, handleAutoCloseTimer)
+}
+
+// handleAutoCloseTimer closes a ticket once its autoclose warning period has passed without a response
+func handleAutoCloseTimer(ctx context.Context, timer redis.Timer) error {
+	var payload logic.AutoCloseTimerPayload
+	if err := json.Unmarshal(timer.Payload, &payload); err != nil {
+		return err
+	}
+
+	ticket, err := dbclient.Client.Tickets.Get(ctx, payload.TicketId, payload.GuildId)
+	if err != nil {
+		return err
+	}
+
+	if ticket.Id == 0 || !ticket.Open || ticket.ChannelId == nil {
+		return nil
+	}
+
+	// The ticket may have been excluded from autoclose during the warning period
+	excluded, err := dbclient.Client.AutoCloseExclude.IsExcluded(ctx, ticket.GuildId, ticket.Id)
+	if err != nil || excluded {
+		return err
+	}
+
+	snoozed, err := dbclient.Storage.TicketSnoozes.IsSnoozed(ctx, ticket.GuildId, ticket.Id)
+	if err != nil || snoozed {
+		return err
+	}
+
+	worker, err := buildContext(ctx, ticket, cache.Client)
+	if err != nil {
+		return err
+	}
+
+	premiumTier, err := utils.PremiumClient.GetTierByGuildId(ctx, ticket.GuildId, true, worker.Token, worker.RateLimiter)
+	if err != nil {
+		return err
+	}
+
+	cc := cmdcontext.NewAutoCloseContext(ctx, worker, ticket.GuildId, *ticket.ChannelId, worker.BotId, premiumTier)
+	logic.CloseTicket(ctx, cc, gdlUtils.StrPtr(AutoCloseReason), true)
+
+	return nil
+}
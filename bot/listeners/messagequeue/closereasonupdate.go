@@ -3,6 +3,7 @@ package messagequeue
 import (
 	"context"
 	"encoding/json"
+	"sync"
 
 	"github.com/TicketsBot-cloud/common/sentry"
 	"github.com/TicketsBot-cloud/worker/bot/cache"
@@ -19,13 +20,26 @@ type CloseReasonUpdatePayload struct {
 	TicketId int    `json:"ticket_id"`
 }
 
-func ListenCloseReasonUpdate() {
+// ListenCloseReasonUpdate processes close reason updates until ctx is cancelled, then waits for in-flight
+// updates to finish
+func ListenCloseReasonUpdate(ctx context.Context) {
 	pubsub := redis.Client.Subscribe(context.Background(), closeReasonUpdateChannel)
-	defer pubsub.Close()
+
+	// Closing the subscription closes the channel below
+	go func() {
+		<-ctx.Done()
+		_ = pubsub.Close()
+	}()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
 
 	for msg := range pubsub.Channel() {
 		msg := msg
+		wg.Add(1)
 		go func() {
+			defer wg.Done()
+
 			var payload CloseReasonUpdatePayload
 			if err := json.Unmarshal([]byte(msg.Payload), &payload); err != nil {
 				sentry.Error(err)
@@ -2,8 +2,8 @@ package messagequeue
 
 import (
 	"context"
+	"sync"
 
-	"github.com/TicketsBot-cloud/common/closerequest"
 	"github.com/TicketsBot-cloud/common/sentry"
 	"github.com/TicketsBot-cloud/database"
 	"github.com/TicketsBot-cloud/worker/bot/cache"
@@ -17,15 +17,26 @@ import (
 	"go.uber.org/zap"
 )
 
-func ListenCloseRequestTimer(logger *zap.Logger) {
+// closeRequestTimerQueue must match the list that common/closerequest publishes to
+const closeRequestTimerQueue = "tickets:closerequest:timer"
+
+// ListenCloseRequestTimer processes expired close requests until ctx is cancelled, then waits for in-flight
+// requests to finish
+func ListenCloseRequestTimer(ctx context.Context, logger *zap.Logger) {
 	ch := make(chan database.CloseRequest)
-	go closerequest.Listen(redis.Client, ch)
+	go redis.ListenQueue(ctx, closeRequestTimerQueue, ch)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
 
 	for request := range ch {
 		statsd.Client.IncrementKey(statsd.AutoClose)
 
 		request := request
+		wg.Add(1)
 		go func() {
+			defer wg.Done()
+
 			ctx, cancel := context.WithTimeout(context.Background(), constants.TimeoutCloseTicket)
 			defer cancel()
 
@@ -0,0 +1,51 @@
+package messagequeue
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/TicketsBot-cloud/worker/bot/cache"
+	cmdcontext "github.com/TicketsBot-cloud/worker/bot/command/context"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/logic"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/bot/scheduler"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+)
+
+func init() {
+	scheduler.Register(logic.TimerKindScheduledClose, handleScheduledCloseTimer)
+}
+
+// handleScheduledCloseTimer closes a ticket once the delay given to /close has passed, on behalf of the user who
+// ran the command
+func handleScheduledCloseTimer(ctx context.Context, timer redis.Timer) error {
+	var payload logic.ScheduledCloseTimerPayload
+	if err := json.Unmarshal(timer.Payload, &payload); err != nil {
+		return err
+	}
+
+	ticket, err := dbclient.Client.Tickets.Get(ctx, payload.TicketId, payload.GuildId)
+	if err != nil {
+		return err
+	}
+
+	if ticket.Id == 0 || !ticket.Open || ticket.ChannelId == nil {
+		return nil
+	}
+
+	worker, err := buildContext(ctx, ticket, cache.Client)
+	if err != nil {
+		return err
+	}
+
+	premiumTier, err := utils.PremiumClient.GetTierByGuildId(ctx, ticket.GuildId, true, worker.Token, worker.RateLimiter)
+	if err != nil {
+		return err
+	}
+
+	cc := cmdcontext.NewAutoCloseContext(ctx, worker, ticket.GuildId, *ticket.ChannelId, payload.UserId, premiumTier)
+	logic.CloseTicket(ctx, cc, payload.Reason, true)
+
+	return nil
+}
@@ -2,6 +2,7 @@ package messagequeue
 
 import (
 	"context"
+	"sync"
 
 	"github.com/TicketsBot-cloud/common/closerelay"
 	"github.com/TicketsBot-cloud/common/sentry"
@@ -17,15 +18,24 @@ import (
 	"github.com/TicketsBot-cloud/worker/config"
 )
 
+// ticketCloseQueue must match the list that common/closerelay publishes to
+const ticketCloseQueue = "tickets:close"
+
 // TODO: Make this good
-func ListenTicketClose() {
+func ListenTicketClose(ctx context.Context) {
 	ch := make(chan closerelay.TicketClose)
-	go closerelay.Listen(redis.Client, ch)
+	go redis.ListenQueue(ctx, ticketCloseQueue, ch)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
 
 	for payload := range ch {
 		payload := payload
 
+		wg.Add(1)
 		go func() {
+			defer wg.Done()
+
 			ctx, cancel := context.WithTimeout(context.Background(), constants.TimeoutCloseTicket)
 			defer cancel()
 
@@ -0,0 +1,16 @@
+package listeners
+
+import "github.com/TicketsBot-cloud/worker"
+
+// SkipRedelivered wraps a listener that is not idempotent, so that it is not run again if an event that has
+// already been processed is delivered a second time. Deduplication must also be enabled for the event type in
+// the event package.
+func SkipRedelivered[T any](listener func(*worker.Context, T) error) func(*worker.Context, T) error {
+	return func(worker *worker.Context, e T) error {
+		if worker.Redelivered {
+			return nil
+		}
+
+		return listener(worker, e)
+	}
+}
@@ -4,7 +4,7 @@ func init() {
 	ChannelDeleteListeners = append(ChannelDeleteListeners, OnChannelDelete)
 	GuildCreateListeners = append(GuildCreateListeners, OnGuildCreate)
 	GuildDeleteListeners = append(GuildDeleteListeners, OnGuildLeave)
-	GuildMemberRemoveListeners = append(GuildMemberRemoveListeners, OnMemberLeave)
+	GuildMemberRemoveListeners = append(GuildMemberRemoveListeners, SkipRedelivered(OnMemberLeave))
 	GuildMemberUpdateListeners = append(GuildMemberUpdateListeners, OnMemberUpdate)
 	GuildUpdateListeners = append(GuildUpdateListeners, OnGuildUpdate)
 	MessageCreateListeners = append(MessageCreateListeners, OnMessage)
@@ -14,24 +14,24 @@ import (
 	"github.com/TicketsBot-cloud/worker/bot/utils"
 )
 
-func OnThreadMembersUpdate(worker *worker.Context, e events.ThreadMembersUpdate) {
+func OnThreadMembersUpdate(worker *worker.Context, e events.ThreadMembersUpdate) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15) // TODO: Propagate context
 	defer cancel()
 
 	settings, err := dbclient.Client.Settings.Get(ctx, e.GuildId)
 	if err != nil {
 		sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-		return
+		return nil
 	}
 
 	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, e.ThreadId, e.GuildId)
 	if err != nil {
 		sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-		return
+		return nil
 	}
 
 	if ticket.Id == 0 || ticket.GuildId != e.GuildId {
-		return
+		return nil
 	}
 
 	if ticket.JoinMessageId != nil {
@@ -40,7 +40,7 @@ func OnThreadMembersUpdate(worker *worker.Context, e events.ThreadMembersUpdate)
 			tmp, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
 			if err != nil {
 				sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-				return
+				return nil
 			}
 
 			if tmp.PanelId != 0 && e.GuildId == tmp.GuildId {
@@ -51,13 +51,13 @@ func OnThreadMembersUpdate(worker *worker.Context, e events.ThreadMembersUpdate)
 		premiumTier, err := utils.PremiumClient.GetTierByGuildId(ctx, e.GuildId, true, worker.Token, worker.RateLimiter)
 		if err != nil {
 			sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-			return
+			return nil
 		}
 
 		threadStaff, err := logic.GetStaffInThread(ctx, worker, ticket, e.ThreadId)
 		if err != nil {
 			sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-			return
+			return nil
 		}
 
 		var notificationChannel *uint64
@@ -75,4 +75,6 @@ func OnThreadMembersUpdate(worker *worker.Context, e events.ThreadMembersUpdate)
 			}
 		}
 	}
+
+	return nil
 }
@@ -4,54 +4,49 @@ import (
 	"context"
 	"time"
 
-	"github.com/TicketsBot-cloud/common/sentry"
 	"github.com/TicketsBot-cloud/database"
 	"github.com/TicketsBot-cloud/gdl/gateway/payloads/events"
 	"github.com/TicketsBot-cloud/worker"
 	cmdcontext "github.com/TicketsBot-cloud/worker/bot/command/context"
 	"github.com/TicketsBot-cloud/worker/bot/constants"
 	"github.com/TicketsBot-cloud/worker/bot/dbclient"
-	"github.com/TicketsBot-cloud/worker/bot/errorcontext"
 	"github.com/TicketsBot-cloud/worker/bot/logic"
 	"github.com/TicketsBot-cloud/worker/bot/utils"
 )
 
-func OnThreadUpdate(worker *worker.Context, e events.ThreadUpdate) {
+func OnThreadUpdate(worker *worker.Context, e events.ThreadUpdate) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*6) // TODO: Propagate context
 	defer cancel()
 
 	if e.ThreadMetadata == nil {
-		return
+		return nil
 	}
 
 	settings, err := dbclient.Client.Settings.Get(ctx, e.GuildId)
 	if err != nil {
-		sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-		return
+		return err
 	}
 
 	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, e.Id, e.GuildId)
 	if err != nil {
-		sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-		return
+		return err
 	}
 
 	if ticket.Id == 0 || ticket.GuildId != e.GuildId {
-		return
+		return nil
 	}
 
 	// Only process archive/unarchive events for the main ticket channel itself
 	// Child threads (like note threads) being archived should not close the ticket
 	if ticket.ChannelId == nil || *ticket.ChannelId != e.Id {
-		return
+		return nil
 	}
 
 	var panel *database.Panel
 	if ticket.PanelId != nil {
 		tmp, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
 		if err != nil {
-			sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-			return
+			return err
 		}
 
 		if tmp.PanelId != 0 && e.GuildId == tmp.GuildId {
@@ -61,35 +56,30 @@ func OnThreadUpdate(worker *worker.Context, e events.ThreadUpdate) {
 
 	premiumTier, err := utils.PremiumClient.GetTierByGuildId(ctx, e.GuildId, true, worker.Token, worker.RateLimiter)
 	if err != nil {
-		sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-		return
+		return err
 	}
 
 	// Handle thread being unarchived
 	if !ticket.Open && !e.ThreadMetadata.Archived && !e.ThreadMetadata.Locked {
 		if err := dbclient.Client.Tickets.SetOpen(ctx, ticket.GuildId, ticket.Id); err != nil {
-			sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-			return
+			return err
 		}
 
 		if settings.TicketNotificationChannel != nil {
 			staffCount, err := logic.GetStaffInThread(ctx, worker, ticket, e.Id)
 			if err != nil {
-				sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-				return
+				return err
 			}
 
 			name, _ := logic.GenerateChannelName(ctx, worker, panel, ticket.GuildId, ticket.Id, ticket.UserId, nil)
 			data := logic.BuildThreadReopenMessage(ctx, worker, ticket.GuildId, ticket.UserId, name, ticket.Id, panel, staffCount, premiumTier)
 			msg, err := worker.CreateMessageComplex(*settings.TicketNotificationChannel, data.IntoCreateMessageData())
 			if err != nil {
-				sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-				return
+				return err
 			}
 
 			if err := dbclient.Client.Tickets.SetJoinMessageId(ctx, ticket.GuildId, ticket.Id, &msg.Id); err != nil {
-				sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-				return
+				return err
 			}
 		}
 	} else if ticket.Open && e.ThreadMetadata.Archived { // Handle ticket being archived on its own
@@ -99,4 +89,6 @@ func OnThreadUpdate(worker *worker.Context, e events.ThreadUpdate) {
 		cc := cmdcontext.NewAutoCloseContext(ctx, worker, ticket.GuildId, e.Id, worker.BotId, premiumTier)
 		logic.CloseTicket(ctx, cc, utils.Ptr("Thread was archived"), true) // TODO: Translate
 	}
+
+	return nil
 }
@@ -0,0 +1,155 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/channel/embed"
+	"github.com/TicketsBot-cloud/gdl/objects/channel/message"
+	"github.com/TicketsBot-cloud/gdl/rest"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+// AutoAssignTicket claims the ticket for an on-call staff member who can access it, if the panel has
+// auto-assignment enabled. Returns 0 if the ticket was left unassigned.
+func AutoAssignTicket(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket) (uint64, error) {
+	// Threads can't be claimed
+	if ticket.PanelId == nil || ticket.IsThread {
+		return 0, nil
+	}
+
+	policy, ok, err := dbclient.Storage.AutoAssignPolicies.Get(ctx, ticket.GuildId, *ticket.PanelId)
+	if err != nil || !ok {
+		return 0, err
+	}
+
+	onCall, err := dbclient.Client.OnCall.GetUsersOnCall(ctx, ticket.GuildId)
+	if err != nil {
+		return 0, err
+	}
+
+	// Candidates are the on-call staff who can see the ticket, whether they are in its teams directly, by role, or
+	// through the default team
+	candidates, err := FilterStaffMembers(ctx, cmd.Worker(), ticket.GuildId, ticket, onCall, true, true)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(candidates) == 0 {
+		return 0, nil
+	}
+
+	openClaims, err := GetOpenClaimCounts(ctx, ticket.GuildId)
+	if err != nil {
+		return 0, err
+	}
+
+	claimLimits, err := LoadClaimLimits(ctx, ticket.GuildId)
+	if err != nil {
+		return 0, err
+	}
+
+	// Apply whichever of the panel's cap and the user's claim limit is stricter
+	eligible := candidates[:0]
+	for _, userId := range candidates {
+		member, err := cmd.Worker().GetGuildMember(ticket.GuildId, userId)
+		if err != nil {
+			return 0, err
+		}
+
+		limit := claimLimits.For(userId, member.Roles)
+		if policy.MaxOpenClaims > 0 && (limit == 0 || policy.MaxOpenClaims < limit) {
+			limit = policy.MaxOpenClaims
+		}
+
+		if limit == 0 || openClaims[userId] < limit {
+			eligible = append(eligible, userId)
+		}
+	}
+
+	candidates = eligible
+
+	if len(candidates) == 0 {
+		return 0, nil
+	}
+
+	var turn int64
+	if policy.Strategy == storage.AutoAssignRoundRobin {
+		turn, err = redis.NextAutoAssignTurn(ctx, ticket.GuildId, *ticket.PanelId)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	assignee := selectAssignee(policy.Strategy, candidates, openClaims, turn)
+
+	if err := ClaimTicket(ctx, cmd, ticket, assignee); err != nil {
+		return 0, err
+	}
+
+	if err := UpdateWelcomeMessageClaimButton(ctx, cmd.Worker(), cmd, ticket, true); err != nil {
+		return assignee, err
+	}
+
+	msgEmbed := utils.BuildEmbed(cmd, customisation.Green, i18n.TitleClaimed, i18n.MessageAutoAssigned, nil, fmt.Sprintf("<@%d>", assignee))
+	_, err = cmd.Worker().CreateMessageComplex(*ticket.ChannelId, rest.CreateMessageData{
+		Content: fmt.Sprintf("<@%d>", assignee),
+		Embeds:  []*embed.Embed{msgEmbed},
+		AllowedMentions: message.AllowedMention{
+			Users: []uint64{assignee},
+		},
+	})
+
+	return assignee, err
+}
+
+// GetOpenClaimCounts returns the number of open tickets claimed by each staff member in the guild
+func GetOpenClaimCounts(ctx context.Context, guildId uint64) (map[uint64]int, error) {
+	tickets, err := dbclient.Client.Tickets.GetGuildOpenTicketsWithMetadata(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uint64]int)
+	for _, ticket := range tickets {
+		if ticket.ClaimedBy != nil {
+			counts[*ticket.ClaimedBy]++
+		}
+	}
+
+	return counts, nil
+}
+
+// selectAssignee picks a user from the non-empty candidates list according to the strategy
+func selectAssignee(strategy storage.AutoAssignStrategy, candidates []uint64, openClaims map[uint64]int, turn int64) uint64 {
+	sorted := make([]uint64, len(candidates))
+	copy(sorted, candidates)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	switch strategy {
+	case storage.AutoAssignLeastLoaded:
+		assignee := sorted[0]
+		for _, userId := range sorted[1:] {
+			if openClaims[userId] < openClaims[assignee] {
+				assignee = userId
+			}
+		}
+
+		return assignee
+	case storage.AutoAssignRandom:
+		return sorted[rand.Intn(len(sorted))]
+	default: // Round robin
+		return sorted[turn%int64(len(sorted))]
+	}
+}
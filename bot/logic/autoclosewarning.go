@@ -0,0 +1,107 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/sentry"
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/channel/embed"
+	"github.com/TicketsBot-cloud/gdl/objects/channel/message"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction/component"
+	"github.com/TicketsBot-cloud/gdl/rest"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/bot/scheduler"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+// TimerKindAutoClose is the kind of the timer that closes a ticket once its autoclose warning period has passed.
+// The handler is registered by the messagequeue package, which is able to build an autoclose command context.
+const TimerKindAutoClose = "autoclose"
+
+type AutoCloseTimerPayload struct {
+	GuildId  uint64 `json:"guild_id"`
+	TicketId int    `json:"ticket_id"`
+}
+
+func autoCloseTimerId(guildId uint64, ticketId int) string {
+	return fmt.Sprintf("%d:%d", guildId, ticketId)
+}
+
+// WarnBeforeAutoClose posts the autoclose warning in the ticket and schedules the close, if the guild has a warning
+// period configured. Returns false if the ticket should instead be closed straight away.
+func WarnBeforeAutoClose(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket) (bool, error) {
+	warning, ok, err := dbclient.Storage.AutoCloseWarnings.Get(ctx, ticket.GuildId)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	timerId := autoCloseTimerId(ticket.GuildId, ticket.Id)
+
+	// The ticket has already been warned, and will be closed once the timer fires
+	_, pending, err := redis.GetTimerFireTime(ctx, TimerKindAutoClose, timerId)
+	if err != nil {
+		return false, err
+	}
+
+	if pending {
+		return true, nil
+	}
+
+	closeAt := time.Now().Add(warning.Period)
+	closeAtFormatted := fmt.Sprintf("<t:%d:R>", closeAt.Unix())
+
+	msgEmbed := utils.BuildEmbed(cmd, customisation.Orange, i18n.TitleAutoclose, i18n.MessageAutoCloseWarning, nil, closeAtFormatted)
+	_, err = cmd.Worker().CreateMessageComplex(*ticket.ChannelId, rest.CreateMessageData{
+		Content: fmt.Sprintf("<@%d>", ticket.UserId),
+		Embeds:  []*embed.Embed{msgEmbed},
+		AllowedMentions: message.AllowedMention{
+			Users: []uint64{ticket.UserId},
+		},
+		Components: []component.Component{
+			component.BuildActionRow(
+				component.BuildButton(component.Button{
+					Label:    cmd.GetMessage(i18n.MessageAutoCloseKeepOpen),
+					CustomId: "autoclose_keep_open",
+					Style:    component.ButtonStyleSuccess,
+					Emoji:    utils.BuildEmoji("🔓"),
+				}),
+			),
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if warning.Dm {
+		if dmChannel, ok := getDmChannel(cmd, ticket.UserId); ok {
+			dmEmbed := utils.BuildEmbed(cmd, customisation.Orange, i18n.TitleAutoclose, i18n.MessageAutoCloseWarningDm, nil, *ticket.ChannelId, closeAtFormatted)
+
+			// The warning has already been posted in the ticket, so don't hold up the close if the user has DMs closed
+			if _, err := cmd.Worker().CreateMessageEmbed(dmChannel, dmEmbed); err != nil {
+				sentry.ErrorWithContext(err, cmd.ToErrorContext())
+			}
+		}
+	}
+
+	payload := AutoCloseTimerPayload{
+		GuildId:  ticket.GuildId,
+		TicketId: ticket.Id,
+	}
+
+	if err := scheduler.Schedule(ctx, TimerKindAutoClose, timerId, closeAt, payload); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CancelAutoClose cancels a pending autoclose, returning true if the ticket had been warned and not yet closed
+func CancelAutoClose(ctx context.Context, guildId uint64, ticketId int) (bool, error) {
+	return scheduler.Cancel(ctx, TimerKindAutoClose, autoCloseTimerId(guildId, ticketId))
+}
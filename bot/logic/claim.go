@@ -18,7 +18,6 @@ import (
 	"github.com/TicketsBot-cloud/worker/bot/customisation"
 	"github.com/TicketsBot-cloud/worker/bot/dbclient"
 	"github.com/TicketsBot-cloud/worker/i18n"
-	"golang.org/x/sync/errgroup"
 )
 
 // ClaimTicket TODO: Keep /add members
@@ -46,6 +45,10 @@ func ClaimTicket(ctx context.Context, cmd registry.CommandContext, ticket databa
 		}
 	}
 
+	if err := checkClaimLimit(ctx, cmd.Worker(), ticket, userId); err != nil {
+		return err
+	}
+
 	// Set to claimed in DB
 	if err := dbclient.Client.TicketClaims.Set(ctx, ticket.GuildId, ticket.Id, userId); err != nil {
 		return err
@@ -150,36 +153,32 @@ func GenerateClaimedOverwrites(ctx context.Context, worker *worker.Context, tick
 			return nil, err
 		}
 
+		var panel *database.Panel
 		if ticket.PanelId != nil {
-			group, _ := errgroup.WithContext(ctx)
-
-			// Get users for support teams of panel
-			group.Go(func() error {
-				userIds, err := dbclient.Client.SupportTeamMembers.GetAllSupportMembersForPanel(ctx, *ticket.PanelId)
-				if err != nil {
-					return err
-				}
-
-				supportUsers = append(supportUsers, userIds...) // No mutex needed
-				return nil
-			})
+			tmp, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
+			if err != nil {
+				return nil, err
+			}
 
-			// Get roles for support teams of panel
-			group.Go(func() error {
-				roleIds, err := dbclient.Client.SupportTeamRoles.GetAllSupportRolesForPanel(ctx, *ticket.PanelId)
-				if err != nil {
-					return err
-				}
+			if tmp.PanelId != 0 && tmp.GuildId == ticket.GuildId {
+				panel = &tmp
+			}
+		}
 
-				supportRoles = append(supportRoles, roleIds...) // No mutex needed
-				return nil
-			})
+		// The ticket may have been routed or escalated to teams other than the panel's
+		teamIds, err := TicketAccessTeamIds(ctx, ticket, panel)
+		if err != nil {
+			return nil, err
+		}
 
-			if err := group.Wait(); err != nil {
-				return nil, err
-			}
+		teamUsers, teamRoles, err := GetTeamUsersAndRoles(ctx, teamIds)
+		if err != nil {
+			return nil, err
 		}
 
+		supportUsers = append(supportUsers, teamUsers...)
+		supportRoles = append(supportRoles, teamRoles...)
+
 		return overwritesCantType(claimer, worker.BotId, ticket.UserId, ticket.GuildId, supportUsers, supportRoles, adminUsers, adminRoles, integrationRoleId, additionalPermissions), nil
 	}
 
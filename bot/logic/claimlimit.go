@@ -0,0 +1,141 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/worker"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+)
+
+// ClaimLimitError is returned by ClaimTicket when the user already has as many open tickets claimed as they are
+// allowed
+type ClaimLimitError struct {
+	Limit int
+}
+
+func (e *ClaimLimitError) Error() string {
+	return fmt.Sprintf("user has reached the limit of %d open claimed tickets", e.Limit)
+}
+
+// ClaimLimits resolves the claim limit that applies to each staff member in a guild
+type ClaimLimits struct {
+	guild  int
+	byUser map[uint64]int
+	byRole map[uint64]int
+}
+
+// LoadClaimLimits fetches the guild's claim limits. A limit set on a support team overrides the guild-wide limit
+// for the team's members, whether they are in the team directly or by role, with the highest applying to users in
+// several such teams.
+func LoadClaimLimits(ctx context.Context, guildId uint64) (ClaimLimits, error) {
+	limits, err := dbclient.Storage.ClaimLimits.Get(ctx, guildId)
+	if err != nil {
+		return ClaimLimits{}, err
+	}
+
+	resolved := ClaimLimits{
+		guild:  limits.Guild,
+		byUser: make(map[uint64]int),
+		byRole: make(map[uint64]int),
+	}
+
+	if len(limits.Teams) == 0 {
+		return resolved, nil
+	}
+
+	teams, err := dbclient.Client.SupportTeam.GetWithMembers(ctx, guildId)
+	if err != nil {
+		return ClaimLimits{}, err
+	}
+
+	for team, members := range teams {
+		limit, ok := limits.Teams[team.Id]
+		if !ok {
+			continue
+		}
+
+		for _, userId := range members {
+			resolved.byUser[userId] = looserClaimLimit(resolved.byUser, userId, limit)
+		}
+
+		roles, err := dbclient.Client.SupportTeamRoles.Get(ctx, team.Id)
+		if err != nil {
+			return ClaimLimits{}, err
+		}
+
+		for _, roleId := range roles {
+			resolved.byRole[roleId] = looserClaimLimit(resolved.byRole, roleId, limit)
+		}
+	}
+
+	return resolved, nil
+}
+
+// For returns the maximum number of open tickets the user, who has the given roles, may have claimed, or 0 if there
+// is no limit
+func (l ClaimLimits) For(userId uint64, roles []uint64) int {
+	limits := make(map[uint64]int)
+	if limit, ok := l.byUser[userId]; ok {
+		limits[userId] = limit
+	}
+
+	for _, roleId := range roles {
+		if limit, ok := l.byRole[roleId]; ok {
+			limits[userId] = looserClaimLimit(limits, userId, limit)
+		}
+	}
+
+	if limit, ok := limits[userId]; ok {
+		return limit
+	}
+
+	return l.guild
+}
+
+// looserClaimLimit returns whichever of limit and the existing limit for key is higher, where 0 means no limit
+func looserClaimLimit(limits map[uint64]int, key uint64, limit int) int {
+	if current, ok := limits[key]; ok && (current == 0 || (limit != 0 && current > limit)) {
+		return current
+	}
+
+	return limit
+}
+
+// checkClaimLimit returns a *ClaimLimitError if claiming the ticket would take the user over their limit
+func checkClaimLimit(ctx context.Context, worker *worker.Context, ticket database.Ticket, userId uint64) error {
+	limits, err := LoadClaimLimits(ctx, ticket.GuildId)
+	if err != nil {
+		return err
+	}
+
+	member, err := worker.GetGuildMember(ticket.GuildId, userId)
+	if err != nil {
+		return err
+	}
+
+	limit := limits.For(userId, member.Roles)
+	if limit == 0 {
+		return nil
+	}
+
+	tickets, err := dbclient.Client.Tickets.GetGuildOpenTicketsWithMetadata(ctx, ticket.GuildId)
+	if err != nil {
+		return err
+	}
+
+	var claimed int
+	for _, openTicket := range tickets {
+		// Re-claiming a ticket the user already has does not add to their load
+		if openTicket.Id != ticket.Id && openTicket.ClaimedBy != nil && *openTicket.ClaimedBy == userId {
+			claimed++
+		}
+	}
+
+	if claimed >= limit {
+		return &ClaimLimitError{Limit: limit}
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package logic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimLimitsFor(t *testing.T) {
+	limits := ClaimLimits{
+		guild:  5,
+		byUser: map[uint64]int{1: 2},
+		byRole: map[uint64]int{10: 3, 11: 0},
+	}
+
+	// The guild-wide limit applies to users outside any limited team
+	require.Equal(t, 5, limits.For(2, nil))
+
+	// Team limits override the guild-wide limit, whether the user is in the team directly or by role
+	require.Equal(t, 2, limits.For(1, nil))
+	require.Equal(t, 3, limits.For(2, []uint64{10}))
+
+	// The highest team limit applies, where 0 means no limit
+	require.Equal(t, 3, limits.For(1, []uint64{10}))
+	require.Equal(t, 0, limits.For(1, []uint64{10, 11}))
+}
@@ -169,6 +169,23 @@ func CloseTicket(ctx context.Context, cmd registry.CommandContext, reason *strin
 	success = true
 	ticket.CloseTime = utils.Ptr(time.Now())
 
+	// Restore any SLA timers paused by a snooze, so that they are counted below
+	if err := ClearTicketSnooze(ctx, ticket); err != nil {
+		sentry.ErrorWithContext(err, errorContext)
+	}
+
+	if err := StopSlaTimers(ctx, ticket); err != nil {
+		sentry.ErrorWithContext(err, errorContext)
+	}
+
+	if err := StopEscalationTimer(ctx, ticket.GuildId, ticket.Id); err != nil {
+		sentry.ErrorWithContext(err, errorContext)
+	}
+
+	if _, err := CancelScheduledClose(ctx, ticket.GuildId, ticket.Id); err != nil {
+		sentry.ErrorWithContext(err, errorContext)
+	}
+
 	// set close reason + user
 	closeMetadata := database.CloseMetadata{
 		Reason: reason,
@@ -0,0 +1,242 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/channel/embed"
+	"github.com/TicketsBot-cloud/gdl/objects/channel/message"
+	"github.com/TicketsBot-cloud/gdl/rest"
+	"github.com/TicketsBot-cloud/worker/bot/cache"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/bot/scheduler"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+const (
+	timerKindEscalation = "escalation"
+
+	// Keep the ping well within the message content length limit
+	maxEscalationMentions = 50
+)
+
+type escalationTimerPayload struct {
+	GuildId  uint64 `json:"guild_id"`
+	TicketId int    `json:"ticket_id"`
+	Pings    int    `json:"pings"`
+}
+
+func init() {
+	scheduler.Register(timerKindEscalation, handleEscalationTimer)
+}
+
+func escalationTimerId(guildId uint64, ticketId int) string {
+	return fmt.Sprintf("%d:%d", guildId, ticketId)
+}
+
+// StartEscalationTimer schedules the first escalation ping for a new ticket, if the guild has escalation enabled
+func StartEscalationTimer(ctx context.Context, ticket database.Ticket) error {
+	policy, ok, err := dbclient.Storage.EscalationPolicies.Get(ctx, ticket.GuildId)
+	if err != nil || !ok {
+		return err
+	}
+
+	payload := escalationTimerPayload{
+		GuildId:  ticket.GuildId,
+		TicketId: ticket.Id,
+	}
+
+	return scheduler.Schedule(ctx, timerKindEscalation, escalationTimerId(ticket.GuildId, ticket.Id), ticket.OpenTime.Add(policy.After), payload)
+}
+
+// StopEscalationTimer cancels any further escalation pings, once staff have responded or the ticket is closed
+func StopEscalationTimer(ctx context.Context, guildId uint64, ticketId int) error {
+	_, err := scheduler.Cancel(ctx, timerKindEscalation, escalationTimerId(guildId, ticketId))
+	return err
+}
+
+func handleEscalationTimer(ctx context.Context, timer redis.Timer) error {
+	var payload escalationTimerPayload
+	if err := json.Unmarshal(timer.Payload, &payload); err != nil {
+		return err
+	}
+
+	ticket, err := dbclient.Client.Tickets.Get(ctx, payload.TicketId, payload.GuildId)
+	if err != nil {
+		return err
+	}
+
+	if ticket.Id == 0 || !ticket.Open || ticket.ChannelId == nil {
+		return nil
+	}
+
+	// The timer is cancelled when staff respond, but check in case the cancellation raced with the timer firing
+	hasResponse, err := dbclient.Client.FirstResponseTime.HasResponse(ctx, ticket.GuildId, ticket.Id)
+	if err != nil || hasResponse {
+		return err
+	}
+
+	// The policy may have been changed or removed since the ticket was opened
+	policy, ok, err := dbclient.Storage.EscalationPolicies.Get(ctx, ticket.GuildId)
+	if err != nil || !ok {
+		return err
+	}
+
+	// A failed ping still counts, and the next one is scheduled regardless, so that one failure does not end the
+	// escalation. The error is returned so that the final ping is retried.
+	sendErr := sendEscalationPing(ctx, ticket, policy)
+
+	payload.Pings++
+	if policy.Interval <= 0 || (policy.MaxPings > 0 && payload.Pings >= policy.MaxPings) {
+		return sendErr
+	}
+
+	if err := scheduler.Schedule(ctx, timerKindEscalation, timer.Id, time.Now().Add(policy.Interval), payload); err != nil {
+		return errors.Join(sendErr, err)
+	}
+
+	return sendErr
+}
+
+func sendEscalationPing(ctx context.Context, ticket database.Ticket, policy storage.EscalationPolicy) error {
+	worker, err := BuildWorkerContext(ctx, ticket.GuildId, cache.Client)
+	if err != nil {
+		return err
+	}
+
+	premiumTier, err := utils.PremiumClient.GetTierByGuildId(ctx, ticket.GuildId, true, worker.Token, worker.RateLimiter)
+	if err != nil {
+		return err
+	}
+
+	roles, users, err := escalationTargets(ctx, ticket, policy)
+	if err != nil {
+		return err
+	}
+
+	content, allowedMentions := buildEscalationMentions(roles, users)
+
+	msgEmbed := utils.BuildEmbedRaw(
+		customisation.GetColourOrDefault(ctx, ticket.GuildId, customisation.Orange),
+		i18n.GetMessageFromGuild(ticket.GuildId, i18n.TitleEscalation),
+		i18n.GetMessageFromGuild(ticket.GuildId, i18n.MessageEscalationNoResponse, fmt.Sprintf("<t:%d:R>", ticket.OpenTime.Unix())),
+		nil,
+		premiumTier,
+	)
+
+	_, err = worker.CreateMessageComplex(*ticket.ChannelId, rest.CreateMessageData{
+		Content:         content,
+		Embeds:          []*embed.Embed{msgEmbed},
+		AllowedMentions: allowedMentions,
+	})
+	return err
+}
+
+// buildEscalationMentions returns the message content pinging the roles and users, and the mentions to allow
+func buildEscalationMentions(roles, users []uint64) (string, message.AllowedMention) {
+	var mentions []string
+	var allowedMentions message.AllowedMention
+	for _, roleId := range roles {
+		if len(mentions) == maxEscalationMentions {
+			break
+		}
+
+		mentions = append(mentions, fmt.Sprintf("<@&%d>", roleId))
+		allowedMentions.Roles = append(allowedMentions.Roles, roleId)
+	}
+
+	for _, userId := range users {
+		if len(mentions) == maxEscalationMentions {
+			break
+		}
+
+		mentions = append(mentions, fmt.Sprintf("<@%d>", userId))
+		allowedMentions.Users = append(allowedMentions.Users, userId)
+	}
+
+	return strings.Join(mentions, " "), allowedMentions
+}
+
+// escalationTargets returns the roles and users to ping for the policy's target
+func escalationTargets(ctx context.Context, ticket database.Ticket, policy storage.EscalationPolicy) ([]uint64, []uint64, error) {
+	switch policy.Target {
+	case storage.EscalationTargetTeam:
+		roles, err := dbclient.Client.SupportTeamRoles.Get(ctx, policy.TeamId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		users, err := dbclient.Client.SupportTeamMembers.Get(ctx, policy.TeamId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return roles, users, nil
+	case storage.EscalationTargetAdmins:
+		roles, err := dbclient.Client.RolePermissions.GetAdminRoles(ctx, ticket.GuildId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		users, err := dbclient.Client.Permissions.GetAdmins(ctx, ticket.GuildId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return roles, users, nil
+	case storage.EscalationTargetUser:
+		return nil, []uint64{policy.UserId}, nil
+	default: // On-call
+		return escalationOnCallRoles(ctx, ticket)
+	}
+}
+
+// escalationOnCallRoles returns the on-call roles of the teams that handle the ticket's panel
+func escalationOnCallRoles(ctx context.Context, ticket database.Ticket) ([]uint64, []uint64, error) {
+	withDefaultTeam := true
+	var roles []uint64
+
+	if ticket.PanelId != nil {
+		panel, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if panel.PanelId != 0 {
+			withDefaultTeam = panel.WithDefaultTeam
+
+			teams, err := dbclient.Client.PanelTeams.GetTeams(ctx, panel.PanelId)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			for _, team := range teams {
+				if team.OnCallRole != nil {
+					roles = append(roles, *team.OnCallRole)
+				}
+			}
+		}
+	}
+
+	if withDefaultTeam {
+		metadata, err := dbclient.Client.GuildMetadata.Get(ctx, ticket.GuildId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if metadata.OnCallRole != nil {
+			roles = append(roles, *metadata.OnCallRole)
+		}
+	}
+
+	return roles, nil, nil
+}
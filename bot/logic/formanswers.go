@@ -0,0 +1,88 @@
+package logic
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/member"
+	"github.com/TicketsBot-cloud/gdl/objects/user"
+	"github.com/TicketsBot-cloud/worker"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+)
+
+// NewTicketFormAnswers converts the answers submitted when opening a ticket into the form they are stored in, ordered
+// by the step of the form they were given in, and then as the inputs are presented on the dashboard. formSteps holds
+// the IDs of the forms the user was shown, in order, and may be nil for single step forms.
+func NewTicketFormAnswers(formData map[database.FormInput]string, formSteps []int) storage.TicketFormAnswers {
+	inputs := make([]database.FormInput, 0, len(formData))
+	for input := range formData {
+		inputs = append(inputs, input)
+	}
+
+	step := func(input database.FormInput) int {
+		for i, formId := range formSteps {
+			if formId == input.FormId {
+				return i
+			}
+		}
+
+		return len(formSteps)
+	}
+
+	sort.Slice(inputs, func(i, j int) bool {
+		if stepI, stepJ := step(inputs[i]), step(inputs[j]); stepI != stepJ {
+			return stepI < stepJ
+		}
+
+		return inputs[i].Position < inputs[j].Position
+	})
+
+	answers := make(storage.TicketFormAnswers, len(inputs))
+	for i, input := range inputs {
+		answers[i] = storage.TicketFormAnswer{
+			Label:    input.Label,
+			CustomId: input.CustomId,
+			Answer:   formData[input],
+		}
+	}
+
+	return answers
+}
+
+// formAnswerKey rebuilds the input label or custom id from the placeholder's parameters, as labels may contain colons
+func formAnswerKey(params []string) string {
+	return strings.Join(params, ":")
+}
+
+// NewFormAnswerSubstitutor handles %form:<input label or custom id>% in naming schemes. The answers are only fetched
+// if the placeholder is used, and are sanitised so that they are valid in a channel name.
+func NewFormAnswerSubstitutor(ctx context.Context, guildId uint64, ticketId int) ParameterizedSubstitutor {
+	var answers storage.TicketFormAnswers
+	var fetched bool
+
+	return NewParameterizedSubstitutor("form", false, false, func(u user.User, m member.Member, params []string) string {
+		if !fetched {
+			answers, _ = dbclient.Storage.TicketFormAnswers.Get(ctx, guildId, ticketId)
+			fetched = true
+		}
+
+		answer, _ := answers.Get(formAnswerKey(params))
+		return SanitizeChannelName(answer)
+	})
+}
+
+// formAnswerPlaceholder handles %form:<input label or custom id>% in messages, escaping markdown as the form answers
+// embed does
+func formAnswerPlaceholder(ctx context.Context, _ *worker.Context, ticket database.Ticket, params []string) string {
+	answers, err := dbclient.Storage.TicketFormAnswers.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return ""
+	}
+
+	answer, _ := answers.Get(formAnswerKey(params))
+	return utils.EscapeMarkdown(answer)
+}
@@ -0,0 +1,76 @@
+package logic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTicketFormAnswers(t *testing.T) {
+	answers := NewTicketFormAnswers(map[database.FormInput]string{
+		{Id: 2, Position: 2, CustomId: "order", Label: "Order: Number"}: "1234",
+		{Id: 1, Position: 1, CustomId: "game", Label: "Game"}:           "Minecraft",
+	}, nil)
+
+	require.Len(t, answers, 2)
+	require.Equal(t, "Game", answers[0].Label)
+
+	answer, ok := answers.Get("game")
+	require.True(t, ok)
+	require.Equal(t, "Minecraft", answer)
+
+	answer, ok = answers.Get(formAnswerKey([]string{"order", " number"}))
+	require.True(t, ok)
+	require.Equal(t, "1234", answer)
+
+	_, ok = answers.Get("missing")
+	require.False(t, ok)
+}
+
+func TestTicketFormAnswersStepOrder(t *testing.T) {
+	answers := NewTicketFormAnswers(map[database.FormInput]string{
+		{Id: 1, FormId: 10, Position: 1, Label: "First"}:  "a",
+		{Id: 2, FormId: 10, Position: 2, Label: "Second"}: "b",
+		{Id: 3, FormId: 20, Position: 1, Label: "Third"}:  "c",
+		{Id: 4, FormId: 30, Position: 1, Label: "Fourth"}: "d",
+	}, []int{20, 10, 30})
+
+	labels := make([]string, len(answers))
+	for i, answer := range answers {
+		labels[i] = answer.Label
+	}
+
+	require.Equal(t, []string{"Third", "First", "Second", "Fourth"}, labels)
+}
+
+func TestBuildFormAnswerEmbeds(t *testing.T) {
+	var answers storage.TicketFormAnswers
+	for i := 0; i < 30; i++ {
+		answers = append(answers, storage.TicketFormAnswer{
+			Label:  strings.Repeat("q", 300),
+			Answer: strings.Repeat("a", 1500),
+		})
+	}
+
+	embeds := BuildFormAnswerEmbeds(answers, 0, true)
+
+	var fields int
+	for _, e := range embeds {
+		require.LessOrEqual(t, len(e.Fields), utils.MaxEmbedFields)
+		require.LessOrEqual(t, utils.EmbedLength(e), utils.MaxEmbedCharacters)
+
+		for _, field := range e.Fields {
+			require.LessOrEqual(t, len(field.Name), utils.MaxEmbedFieldName)
+			require.LessOrEqual(t, len(field.Value), utils.MaxEmbedFieldValue)
+		}
+
+		fields += len(e.Fields)
+	}
+
+	require.Equal(t, len(answers), fields)
+	require.NotNil(t, embeds[len(embeds)-1].Footer)
+}
@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+)
+
+// MaxFormSteps is the number of modals a user can be shown before their ticket is opened, which stops forms that
+// branch back into each other from going on forever
+const MaxFormSteps = 10
+
+// NextFormStep returns the ID of the form that should be shown after a step, given the answers to every step so far
+// by form input ID, or 0 if the form is finished
+func NextFormStep(flow storage.FormFlow, answers map[int]string) int {
+	for _, branch := range flow.Branches {
+		answer, ok := answers[branch.InputId]
+		if ok && formAnswerMatches(answer, branch.Values) {
+			return branch.NextFormId
+		}
+	}
+
+	return flow.DefaultNextFormId
+}
+
+// formAnswerMatches checks whether the answer, or any of the options picked in a multi-value select, is one of the
+// values, ignoring case and surrounding whitespace
+func formAnswerMatches(answer string, values []string) bool {
+	parts := append([]string{answer}, strings.Split(answer, ",")...)
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		for _, value := range values {
+			if strings.EqualFold(part, strings.TrimSpace(value)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// IsValidFormStep checks that the form belongs to the guild and has inputs to show
+func IsValidFormStep(ctx context.Context, guildId uint64, formId int) (bool, error) {
+	form, ok, err := dbclient.Client.Forms.Get(ctx, formId)
+	if err != nil || !ok || form.GuildId != guildId {
+		return false, err
+	}
+
+	inputs, err := dbclient.Client.FormInput.GetInputs(ctx, form.Id)
+	if err != nil {
+		return false, err
+	}
+
+	return len(inputs) > 0, nil
+}
@@ -0,0 +1,24 @@
+package logic
+
+import (
+	"testing"
+
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextFormStep(t *testing.T) {
+	flow := storage.FormFlow{
+		Branches: []storage.FormBranch{
+			{InputId: 1, Values: []string{"billing", "payments"}, NextFormId: 10},
+			{InputId: 2, Values: []string{"yes"}, NextFormId: 20},
+		},
+		DefaultNextFormId: 30,
+	}
+
+	require.Equal(t, 10, NextFormStep(flow, map[int]string{1: " Billing "}))
+	require.Equal(t, 10, NextFormStep(flow, map[int]string{1: "refunds, payments"}))
+	require.Equal(t, 20, NextFormStep(flow, map[int]string{1: "technical", 2: "YES"}))
+	require.Equal(t, 30, NextFormStep(flow, map[int]string{1: "technical"}))
+	require.Equal(t, 0, NextFormStep(storage.FormFlow{}, map[int]string{1: "billing"}))
+}
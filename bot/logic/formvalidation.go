@@ -0,0 +1,155 @@
+package logic
+
+import (
+	"context"
+	"math"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+// FormValidationError describes why the answer to a form input was rejected
+type FormValidationError struct {
+	Input   database.FormInput
+	Message i18n.MessageId
+	Format  []interface{}
+	// CustomMessage is the guild's own error message for the rule, which is shown instead of Message if set
+	CustomMessage string
+}
+
+func (e FormValidationError) Reason(cmd registry.CommandContext) string {
+	if e.CustomMessage != "" {
+		return e.CustomMessage
+	}
+
+	return cmd.GetMessage(e.Message, e.Format...)
+}
+
+// ValidateFormAnswers checks that required answers are present and that every answer passes the guild's validation
+// rules, returning an error for each invalid answer in the order the inputs appear on the form
+func ValidateFormAnswers(ctx context.Context, guildId uint64, answers map[database.FormInput]string) ([]FormValidationError, error) {
+	rules, err := dbclient.Storage.FormValidation.GetAll(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []FormValidationError
+	for input, answer := range answers {
+		// Check that users have not just pressed newline or space
+		if strings.TrimSpace(answer) == "" {
+			if input.Required {
+				errs = append(errs, FormValidationError{
+					Input:   input,
+					Message: i18n.MessageFormValidationRequired,
+				})
+			}
+
+			continue
+		}
+
+		rule, ok := rules[input.Id]
+		if !ok {
+			continue
+		}
+
+		if message, format, valid := ValidateFormAnswer(rule, answer); !valid {
+			validationErr := FormValidationError{
+				Input:   input,
+				Message: message,
+				Format:  format,
+			}
+
+			if rule.Type == storage.FormValidationRegex && message == i18n.MessageFormValidationPattern {
+				validationErr.CustomMessage = rule.ErrorMessage
+			}
+
+			errs = append(errs, validationErr)
+		}
+	}
+
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Input.Position < errs[j].Input.Position
+	})
+
+	return errs, nil
+}
+
+// ValidateFormAnswer checks a non-empty answer against a rule, returning the message describing why it is invalid
+func ValidateFormAnswer(rule storage.FormValidationRule, answer string) (i18n.MessageId, []interface{}, bool) {
+	length := utf8.RuneCountInString(answer)
+	if rule.MinLength != nil && length < *rule.MinLength {
+		return i18n.MessageFormValidationTooShort, []interface{}{*rule.MinLength}, false
+	}
+
+	if rule.MaxLength != nil && length > *rule.MaxLength {
+		return i18n.MessageFormValidationTooLong, []interface{}{*rule.MaxLength}, false
+	}
+
+	answer = strings.TrimSpace(answer)
+
+	switch rule.Type {
+	case storage.FormValidationRegex:
+		// Patterns are checked when the rule is set, so one that doesn't compile can only be ignored
+		pattern, err := regexp.Compile(rule.Pattern)
+		if err == nil && !pattern.MatchString(answer) {
+			return i18n.MessageFormValidationPattern, nil, false
+		}
+	case storage.FormValidationInteger:
+		value, err := strconv.ParseInt(answer, 10, 64)
+		if err != nil {
+			return i18n.MessageFormValidationInteger, nil, false
+		}
+
+		return validateRange(rule, float64(value))
+	case storage.FormValidationDecimal:
+		value, err := strconv.ParseFloat(answer, 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			return i18n.MessageFormValidationDecimal, nil, false
+		}
+
+		return validateRange(rule, value)
+	case storage.FormValidationEmail:
+		address, err := mail.ParseAddress(answer)
+		if err != nil || address.Address != answer || !strings.Contains(answer[strings.LastIndex(answer, "@"):], ".") {
+			return i18n.MessageFormValidationEmail, nil, false
+		}
+	case storage.FormValidationUrl:
+		parsed, err := url.ParseRequestURI(answer)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return i18n.MessageFormValidationUrl, nil, false
+		}
+	case storage.FormValidationSnowflake:
+		// Snowflakes are at least 17 digits long, as their timestamp is counted from 2015
+		if _, err := strconv.ParseUint(answer, 10, 64); err != nil || len(answer) < 17 {
+			return i18n.MessageFormValidationSnowflake, nil, false
+		}
+	}
+
+	return "", nil, true
+}
+
+func validateRange(rule storage.FormValidationRule, value float64) (i18n.MessageId, []interface{}, bool) {
+	if rule.Min != nil && value < *rule.Min {
+		return i18n.MessageFormValidationTooSmall, []interface{}{formatFloat(*rule.Min)}, false
+	}
+
+	if rule.Max != nil && value > *rule.Max {
+		return i18n.MessageFormValidationTooLarge, []interface{}{formatFloat(*rule.Max)}, false
+	}
+
+	return "", nil, true
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
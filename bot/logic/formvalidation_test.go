@@ -0,0 +1,84 @@
+package logic
+
+import (
+	"testing"
+
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/i18n"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateFormAnswerLength(t *testing.T) {
+	minLength, maxLength := 3, 5
+	rule := storage.FormValidationRule{Type: storage.FormValidationText, MinLength: &minLength, MaxLength: &maxLength}
+
+	message, _, valid := ValidateFormAnswer(rule, "ab")
+	require.False(t, valid)
+	require.Equal(t, i18n.MessageFormValidationTooShort, message)
+
+	message, _, valid = ValidateFormAnswer(rule, "abcdef")
+	require.False(t, valid)
+	require.Equal(t, i18n.MessageFormValidationTooLong, message)
+
+	_, _, valid = ValidateFormAnswer(rule, "ábcd")
+	require.True(t, valid)
+}
+
+func TestValidateFormAnswerRegex(t *testing.T) {
+	rule := storage.FormValidationRule{Type: storage.FormValidationRegex, Pattern: `^ORD-\d{6}$`}
+
+	_, _, valid := ValidateFormAnswer(rule, "ORD-123456")
+	require.True(t, valid)
+
+	message, _, valid := ValidateFormAnswer(rule, "123456")
+	require.False(t, valid)
+	require.Equal(t, i18n.MessageFormValidationPattern, message)
+}
+
+func TestValidateFormAnswerRange(t *testing.T) {
+	minValue, maxValue := 1.0, 10.0
+	rule := storage.FormValidationRule{Type: storage.FormValidationInteger, Min: &minValue, Max: &maxValue}
+
+	_, _, valid := ValidateFormAnswer(rule, " 7 ")
+	require.True(t, valid)
+
+	message, _, valid := ValidateFormAnswer(rule, "7.5")
+	require.False(t, valid)
+	require.Equal(t, i18n.MessageFormValidationInteger, message)
+
+	message, format, valid := ValidateFormAnswer(rule, "11")
+	require.False(t, valid)
+	require.Equal(t, i18n.MessageFormValidationTooLarge, message)
+	require.Equal(t, []interface{}{"10"}, format)
+
+	rule.Type = storage.FormValidationDecimal
+	_, _, valid = ValidateFormAnswer(rule, "7.5")
+	require.True(t, valid)
+
+	message, _, valid = ValidateFormAnswer(rule, "NaN")
+	require.False(t, valid)
+	require.Equal(t, i18n.MessageFormValidationDecimal, message)
+}
+
+func TestValidateFormAnswerFormats(t *testing.T) {
+	cases := []struct {
+		validationType storage.FormValidationType
+		answer         string
+		valid          bool
+	}{
+		{storage.FormValidationEmail, "user@example.com", true},
+		{storage.FormValidationEmail, "User <user@example.com>", false},
+		{storage.FormValidationEmail, "user@localhost", false},
+		{storage.FormValidationUrl, "https://example.com/path", true},
+		{storage.FormValidationUrl, "ftp://example.com", false},
+		{storage.FormValidationUrl, "example.com", false},
+		{storage.FormValidationSnowflake, "508391840525975553", true},
+		{storage.FormValidationSnowflake, "12345", false},
+		{storage.FormValidationSnowflake, "-508391840525975553", false},
+	}
+
+	for _, tc := range cases {
+		_, _, valid := ValidateFormAnswer(storage.FormValidationRule{Type: tc.validationType}, tc.answer)
+		require.Equal(t, tc.valid, valid, tc.answer)
+	}
+}
@@ -0,0 +1,281 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/TicketsBot-cloud/common/sentry"
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/channel/embed"
+	"github.com/TicketsBot-cloud/gdl/objects/channel/message"
+	"github.com/TicketsBot-cloud/gdl/rest"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+	"github.com/TicketsBot-cloud/worker/config"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+const (
+	// mergeReplayLimit is the number of the source ticket's most recent messages that are copied into the target
+	mergeReplayLimit = 25
+
+	mergeCloseReasonFormat = "Merged into #%d"
+)
+
+// MergeTicket closes the ticket in the command's channel into the target ticket, after copying across its recent
+// messages, participants, labels and form answers
+func MergeTicket(ctx context.Context, cmd registry.CommandContext, targetId int) {
+	source, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, cmd.ChannelId(), cmd.GuildId())
+	if err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	if source.Id == 0 {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	if source.Id == targetId {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageMergeSameTicket)
+		return
+	}
+
+	target, err := dbclient.Client.Tickets.Get(ctx, targetId, cmd.GuildId())
+	if err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	if target.Id == 0 || target.GuildId != cmd.GuildId() || !target.Open || target.ChannelId == nil {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageMergeInvalidTarget)
+		return
+	}
+
+	if target.UserId != source.UserId {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageMergeDifferentUser)
+		return
+	}
+
+	for _, ticket := range []database.Ticket{source, target} {
+		hasPermission, err := HasPermissionForTicket(ctx, cmd.Worker(), ticket, cmd.UserId())
+		if err != nil {
+			cmd.HandleError(err)
+			return
+		}
+
+		if !hasPermission {
+			cmd.Reply(customisation.Red, i18n.Error, i18n.MessageMergeNoPermission)
+			return
+		}
+	}
+
+	if err := copyMergeMetadata(ctx, source, target); err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	// Post the notice first, so that the replayed messages appear underneath it
+	noticeEmbed := utils.BuildEmbed(cmd, customisation.Green, i18n.TitleMerge, i18n.MessageMergeTargetNotice, nil, source.Id, transcriptUrl(source))
+	formAnswerEmbeds, err := getFormAnswerEmbeds(ctx, cmd, source)
+	if err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	for _, batch := range utils.BatchEmbeds(append([]*embed.Embed{noticeEmbed}, formAnswerEmbeds...)) {
+		if _, err := cmd.Worker().CreateMessageComplex(*target.ChannelId, rest.CreateMessageData{
+			Embeds: batch,
+		}); err != nil {
+			cmd.HandleError(err)
+			return
+		}
+	}
+
+	// The merge should still go ahead if some of the messages could not be copied
+	if err := replayMessages(ctx, cmd, source, target); err != nil {
+		sentry.ErrorWithContext(err, cmd.ToErrorContext())
+	}
+
+	if _, err := cmd.Worker().CreateMessageEmbed(*source.ChannelId, utils.BuildEmbed(cmd, customisation.Green, i18n.TitleMerge, i18n.MessageMergeSourceNotice, nil, target.Id, *target.ChannelId, transcriptUrl(target))); err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	CloseTicket(ctx, cmd, utils.Ptr(fmt.Sprintf(mergeCloseReasonFormat, target.Id)), true)
+}
+
+func transcriptUrl(ticket database.Ticket) string {
+	return fmt.Sprintf("%s/manage/%d/transcripts/view/%d", config.Conf.Bot.DashboardUrl, ticket.GuildId, ticket.Id)
+}
+
+// copyMergeMetadata adds the source ticket's participants, labels and form answers to the target ticket
+func copyMergeMetadata(ctx context.Context, source, target database.Ticket) error {
+	if err := copyMergeFormAnswers(ctx, source, target); err != nil {
+		return err
+	}
+
+	participants, err := dbclient.Client.Participants.GetParticipants(ctx, source.GuildId, source.Id)
+	if err != nil {
+		return err
+	}
+
+	if len(participants) > 0 {
+		if err := dbclient.Client.Participants.SetBulk(ctx, target.GuildId, target.Id, participants); err != nil {
+			return err
+		}
+	}
+
+	sourceLabels, err := dbclient.Client.TicketLabelAssignments.GetByTicket(ctx, source.GuildId, source.Id)
+	if err != nil {
+		return err
+	}
+
+	if len(sourceLabels) == 0 {
+		return nil
+	}
+
+	labels, err := dbclient.Client.TicketLabelAssignments.GetByTicket(ctx, target.GuildId, target.Id)
+	if err != nil {
+		return err
+	}
+
+	for _, labelId := range sourceLabels {
+		if !utils.Contains(labels, labelId) {
+			labels = append(labels, labelId)
+		}
+	}
+
+	return dbclient.Client.TicketLabelAssignments.Replace(ctx, target.GuildId, target.Id, labels)
+}
+
+// copyMergeFormAnswers appends the source ticket's form answers to the target's, so that they are still shown in the
+// ticket's info and used by integrations once the source is closed. The target's own answers come first, so they take
+// precedence when both tickets answered the same input.
+func copyMergeFormAnswers(ctx context.Context, source, target database.Ticket) error {
+	sourceAnswers, err := dbclient.Storage.TicketFormAnswers.Get(ctx, source.GuildId, source.Id)
+	if err != nil {
+		return err
+	}
+
+	if len(sourceAnswers) == 0 {
+		return nil
+	}
+
+	answers, err := dbclient.Storage.TicketFormAnswers.Get(ctx, target.GuildId, target.Id)
+	if err != nil {
+		return err
+	}
+
+	for _, answer := range sourceAnswers {
+		if !utils.Contains(answers, answer) {
+			answers = append(answers, answer)
+		}
+	}
+
+	return dbclient.Storage.TicketFormAnswers.Set(ctx, target.GuildId, target.Id, answers)
+}
+
+// getFormAnswerEmbeds returns embeds holding the answers given when the ticket was opened, if it was opened with a form
+func getFormAnswerEmbeds(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket) ([]*embed.Embed, error) {
+	formAnswers, err := dbclient.Storage.TicketFormAnswers.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	colour := customisation.GetColourOrDefault(ctx, ticket.GuildId, customisation.Green)
+	return BuildFormAnswerEmbeds(formAnswers, colour, false), nil
+}
+
+// replayMessages copies the source ticket's most recent messages into the target, quoted. The target's webhook is
+// used where possible so that each message appears under its author's name, but webhooks can't post into threads.
+func replayMessages(ctx context.Context, cmd registry.CommandContext, source, target database.Ticket) error {
+	messages, err := cmd.Worker().GetChannelMessages(*source.ChannelId, rest.GetChannelMessagesData{
+		Limit: mergeReplayLimit,
+	})
+	if err != nil {
+		return err
+	}
+
+	var webhook database.Webhook
+	if !target.IsThread {
+		webhook, err = dbclient.Client.Webhooks.Get(ctx, target.GuildId, target.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	colour := customisation.GetColourOrDefault(ctx, target.GuildId, customisation.Green)
+	var fallback []*embed.Embed
+
+	// Messages are returned newest first
+	for i := len(messages) - 1; i >= 0; i-- {
+		msg := messages[i]
+		if msg.Author.Bot {
+			continue
+		}
+
+		content := quoteMessage(msg)
+		if content == "" {
+			continue
+		}
+
+		if webhook.Id != 0 {
+			_, err := cmd.Worker().ExecuteWebhook(webhook.Id, webhook.Token, false, rest.WebhookBody{
+				Content:         content,
+				Username:        msg.Author.EffectiveName(),
+				AvatarUrl:       msg.Author.AvatarUrl(256),
+				AllowedMentions: message.AllowedMention{},
+			})
+
+			if err == nil {
+				continue
+			}
+
+			// The webhook may have been deleted, so post the remaining messages ourselves
+			webhook.Id = 0
+		}
+
+		fallback = append(fallback, embed.NewEmbed().
+			SetAuthor(msg.Author.EffectiveName(), "", msg.Author.AvatarUrl(256)).
+			SetDescription(content).
+			SetTimestamp(msg.Timestamp).
+			SetColor(colour))
+	}
+
+	for _, batch := range utils.BatchEmbeds(fallback) {
+		if _, err := cmd.Worker().CreateMessageComplex(*target.ChannelId, rest.CreateMessageData{
+			Embeds: batch,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func quoteMessage(msg message.Message) string {
+	lines := strings.Split(strings.TrimSpace(msg.Content), "\n")
+	if len(lines) == 1 && lines[0] == "" {
+		lines = nil
+	}
+
+	for _, attachment := range msg.Attachments {
+		lines = append(lines, attachment.Url)
+	}
+
+	for i, line := range lines {
+		lines[i] = "> " + line
+	}
+
+	// Discord counts the limit in characters, and slicing bytes could split a multi-byte character
+	quoted := strings.Join(lines, "\n")
+	if runes := []rune(quoted); len(runes) > 2000 {
+		quoted = string(runes[:1997]) + "..."
+	}
+
+	return quoted
+}
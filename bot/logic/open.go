@@ -31,12 +31,13 @@ import (
 	"github.com/TicketsBot-cloud/worker/bot/metrics/statsd"
 	"github.com/TicketsBot-cloud/worker/bot/permissionwrapper"
 	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
 	"github.com/TicketsBot-cloud/worker/bot/utils"
 	"github.com/TicketsBot-cloud/worker/i18n"
 	"golang.org/x/sync/errgroup"
 )
 
-func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *database.Panel, subject string, formData map[database.FormInput]string, outOfHoursTitle *string, outOfHoursWarning *string, outOfHoursColour *int) (database.Ticket, error) {
+func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *database.Panel, subject string, formData map[database.FormInput]string, formSteps []int, outOfHoursTitle *string, outOfHoursWarning *string, outOfHoursColour *int) (database.Ticket, error) {
 	rootSpan := sentry.StartSpan(ctx, "Ticket open")
 	rootSpan.SetTag("guild", strconv.FormatUint(cmd.GuildId(), 10))
 	defer rootSpan.Finish()
@@ -196,10 +197,25 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 		}
 	}
 
+	span = sentry.StartSpan(rootSpan.Context(), "Match routing rules")
+	routingSettings, err := dbclient.Storage.RoutingSettings.Get(ctx, cmd.GuildId())
+	if err != nil {
+		sentry.ErrorWithContext(err, cmd.ToErrorContext())
+	}
+
+	routingRule, routed := MatchRoutingRule(routingSettings.Rules, NewRoutingInput(cmd, panel, formData))
+
+	// In dry run mode, the matched rule is only reported once the ticket has been opened
+	applyRouting := routed && !routingSettings.DryRun
+	span.Finish()
+
 	// If we're using a panel, then we need to create the ticket in the specified category
 	span = sentry.StartSpan(rootSpan.Context(), "Get category")
 	var category uint64
-	if panel != nil && panel.TargetCategory != 0 {
+	routedCategory := applyRouting && routingRule.CategoryId != 0
+	if routedCategory {
+		category = routingRule.CategoryId
+	} else if panel != nil && panel.TargetCategory != 0 {
 		category = panel.TargetCategory
 	} else { // else we can just use the default category
 		var err error
@@ -220,7 +236,7 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 			useCategory = false
 
 			if restError, ok := err.(request.RestError); ok && restError.StatusCode == 404 {
-				if panel == nil {
+				if panel == nil && !routedCategory {
 					if err := dbclient.Client.ChannelCategory.Delete(ctx, cmd.GuildId()); err != nil {
 						cmd.HandleError(err)
 					}
@@ -281,6 +297,46 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 		return database.Ticket{}, err
 	}
 
+	// The priority is set before the channel is named, as the naming scheme may contain it
+	span = sentry.StartSpan(rootSpan.Context(), "Set ticket priority")
+	prioritySettings, err := dbclient.Storage.PrioritySettings.Get(ctx, cmd.GuildId())
+	if err != nil {
+		sentry.ErrorWithContext(err, cmd.ToErrorContext())
+	}
+
+	priority := DefaultTicketPriority(prioritySettings, panel, formData)
+	if priority != storage.PriorityNormal {
+		if err := dbclient.Storage.TicketPriorities.Set(ctx, cmd.GuildId(), ticketId, priority); err != nil {
+			sentry.ErrorWithContext(err, cmd.ToErrorContext())
+		}
+	}
+	span.Finish()
+
+	// The routing is stored before the channel is named, as the rule may override the naming scheme
+	if applyRouting {
+		span = sentry.StartSpan(rootSpan.Context(), "Store ticket routing")
+		routing := storage.TicketRouting{
+			Rule:         routingRule.Name,
+			TeamIds:      routingRule.TeamIds,
+			NamingScheme: routingRule.NamingScheme,
+		}
+
+		if err := dbclient.Storage.TicketRouting.Set(ctx, cmd.GuildId(), ticketId, routing); err != nil {
+			sentry.ErrorWithContext(err, cmd.ToErrorContext())
+		}
+		span.Finish()
+	}
+
+	// The form answers are stored before the channel is named, as the naming scheme may contain them
+	formAnswers := NewTicketFormAnswers(formData, formSteps)
+	if len(formAnswers) > 0 {
+		span = sentry.StartSpan(rootSpan.Context(), "Store form answers")
+		if err := dbclient.Storage.TicketFormAnswers.Set(ctx, cmd.GuildId(), ticketId, formAnswers); err != nil {
+			sentry.ErrorWithContext(err, cmd.ToErrorContext())
+		}
+		span.Finish()
+	}
+
 	span = sentry.StartSpan(rootSpan.Context(), "Generate channel name")
 	name, err := GenerateChannelName(ctx, cmd.Worker(), panel, cmd.GuildId(), ticketId, cmd.UserId(), nil)
 	if err != nil {
@@ -347,7 +403,13 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 		}
 	} else {
 		span = sentry.StartSpan(rootSpan.Context(), "Build permission overwrites")
-		overwrites, err := CreateOverwrites(ctx, cmd, cmd.UserId(), panel, category)
+		var overwrites []channel.PermissionOverwrite
+		if applyRouting && len(routingRule.TeamIds) > 0 {
+			overwrites, err = CreateOverwritesForTeams(ctx, cmd, cmd.UserId(), panel, category, routingRule.TeamIds)
+		} else {
+			overwrites, err = CreateOverwrites(ctx, cmd, cmd.UserId(), panel, category)
+		}
+
 		if err != nil {
 			cmd.HandleError(err)
 			return database.Ticket{}, err
@@ -412,6 +474,14 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 
 	prometheus.TicketsCreated.Inc()
 
+	if applyRouting && len(routingRule.LabelIds) > 0 {
+		span = sentry.StartSpan(rootSpan.Context(), "Assign routing labels")
+		if err := dbclient.Client.TicketLabelAssignments.Replace(ctx, cmd.GuildId(), ticketId, routingRule.LabelIds); err != nil {
+			sentry.ErrorWithContext(err, cmd.ToErrorContext())
+		}
+		span.Finish()
+	}
+
 	// Parallelise as much as possible
 	group, _ := errgroup.WithContext(ctx)
 
@@ -447,7 +517,7 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 		externalPlaceholderCtx, cancel := context.WithTimeout(ctx, time.Second*5)
 		defer cancel()
 
-		additionalPlaceholders, err := fetchCustomIntegrationPlaceholders(externalPlaceholderCtx, ticket, formAnswersToMap(formData))
+		additionalPlaceholders, err := fetchCustomIntegrationPlaceholders(externalPlaceholderCtx, ticket, formAnswers)
 		if err != nil {
 			// TODO: Log for integration author and server owner on the dashboard, rather than spitting out a message.
 			// A failing integration should not block the ticket creation process.
@@ -456,7 +526,7 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 		span.Finish()
 
 		span = sentry.StartSpan(rootSpan.Context(), "Send welcome message")
-		msgId, err := SendWelcomeMessage(ctx, cmd, ticket, subject, panel, formData, additionalPlaceholders)
+		msgId, err := SendWelcomeMessage(ctx, cmd, ticket, subject, panel, formAnswers, additionalPlaceholders)
 		span.Finish()
 		if err != nil {
 			return err
@@ -503,7 +573,7 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 				span.Finish()
 				if err != nil {
 					return err
-				} else {
+				} else if !applyRouting || len(routingRule.TeamIds) == 0 {
 					for _, team := range teams {
 						if team.OnCallRole != nil {
 							content += fmt.Sprintf("<@&%d>", *team.OnCallRole)
@@ -511,9 +581,39 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 					}
 				}
 			}
+
+			// Teams that the ticket was routed to replace the panel's teams
+			if applyRouting {
+				for _, teamId := range routingRule.TeamIds {
+					team, ok, err := dbclient.Client.SupportTeam.GetById(ctx, cmd.GuildId(), teamId)
+					if err != nil {
+						return err
+					}
+
+					if ok && team.OnCallRole != nil {
+						content += fmt.Sprintf("<@&%d>", *team.OnCallRole)
+					}
+				}
+			}
 		}
 
-		if panel != nil {
+		// Urgent tickets ping the guild's urgent role instead of the panel's mentions
+		if priority == storage.PriorityUrgent && prioritySettings.UrgentRoleId != 0 {
+			if prioritySettings.UrgentRoleId == cmd.GuildId() {
+				content += "@everyone"
+			} else {
+				content += fmt.Sprintf("<@&%d>", prioritySettings.UrgentRoleId)
+			}
+		} else if applyRouting && len(routingRule.MentionRoleIds) > 0 {
+			// Routing rules replace the panel's mentions
+			for _, roleId := range routingRule.MentionRoleIds {
+				if roleId == cmd.GuildId() {
+					content += "@everyone"
+				} else {
+					content += fmt.Sprintf("<@&%d>", roleId)
+				}
+			}
+		} else if panel != nil {
 			// roles
 			span := sentry.StartSpan(rootSpan.Context(), "Get panel role mentions from database")
 			roles, err := dbclient.Client.PanelRoleMentions.GetRoles(ctx, panel.PanelId)
@@ -640,6 +740,45 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 		span.Finish()
 	}
 
+	if welcomeMessageId != 0 {
+		ticket.WelcomeMessageId = &welcomeMessageId
+	}
+
+	// Auto-assign once the welcome message has been sent, so that its claim button can be updated
+	span = sentry.StartSpan(rootSpan.Context(), "Auto-assign ticket")
+	if _, err := AutoAssignTicket(ctx, cmd, ticket); err != nil {
+		sentry.ErrorWithContext(err, cmd.ToErrorContext())
+	}
+	span.Finish()
+
+	span = sentry.StartSpan(rootSpan.Context(), "Start SLA timers")
+	if err := StartSlaTimers(ctx, ticket); err != nil {
+		sentry.ErrorWithContext(err, cmd.ToErrorContext())
+	}
+	span.Finish()
+
+	span = sentry.StartSpan(rootSpan.Context(), "Start escalation timer")
+	if err := StartEscalationTimer(ctx, ticket); err != nil {
+		sentry.ErrorWithContext(err, cmd.ToErrorContext())
+	}
+	span.Finish()
+
+	if !isThread && prioritySettings.Reorder {
+		span = sentry.StartSpan(rootSpan.Context(), "Reorder ticket channels")
+		if err := ReorderTicketChannels(ctx, cmd.Worker(), cmd.GuildId(), ch); err != nil {
+			sentry.ErrorWithContext(err, cmd.ToErrorContext())
+		}
+		span.Finish()
+	}
+
+	if routingSettings.DryRun && routingSettings.DryRunChannelId != 0 && len(routingSettings.Rules) > 0 {
+		span = sentry.StartSpan(rootSpan.Context(), "Report routing dry run")
+		if err := reportRoutingDryRun(cmd, routingSettings, ticketId, ch, routingRule, routed); err != nil {
+			sentry.ErrorWithContext(err, cmd.ToErrorContext())
+		}
+		span.Finish()
+	}
+
 	span = sentry.StartSpan(rootSpan.Context(), "Increment statsd counters")
 	statsd.Client.IncrementKey(statsd.KeyTickets)
 	if panel == nil {
@@ -905,6 +1044,37 @@ func createWebhook(ctx context.Context, c registry.CommandContext, ticketId int,
 }
 
 func CreateOverwrites(ctx context.Context, cmd registry.InteractionContext, userId uint64, panel *database.Panel, categoryId uint64, otherUsers ...uint64) ([]channel.PermissionOverwrite, error) {
+	var teamIds []int
+	if panel != nil {
+		var err error
+		teamIds, err = dbclient.Client.PanelTeams.GetTeamIds(ctx, panel.PanelId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return CreateOverwritesForTeams(ctx, cmd, userId, panel, categoryId, teamIds, otherUsers...)
+}
+
+// CreateTicketOverwrites builds the overwrites for an existing ticket, giving access to the teams it was routed to
+// and those it has been escalated to
+func CreateTicketOverwrites(ctx context.Context, cmd registry.InteractionContext, ticket database.Ticket, panel *database.Panel, categoryId uint64, otherUsers ...uint64) ([]channel.PermissionOverwrite, error) {
+	teamIds, err := TicketTeamIds(ctx, ticket, panel)
+	if err != nil {
+		return nil, err
+	}
+
+	overwrites, err := CreateOverwritesForTeams(ctx, cmd, ticket.UserId, panel, categoryId, teamIds, otherUsers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return AppendEscalationOverwrites(ctx, cmd.Worker(), ticket, overwrites)
+}
+
+// CreateOverwritesForTeams builds the overwrites for a ticket, giving access to the given support teams in place of
+// the panel's
+func CreateOverwritesForTeams(ctx context.Context, cmd registry.InteractionContext, userId uint64, panel *database.Panel, categoryId uint64, teamIds []int, otherUsers ...uint64) ([]channel.PermissionOverwrite, error) {
 	overwrites := []channel.PermissionOverwrite{ // @everyone
 		{
 			Id:    cmd.GuildId(),
@@ -1019,57 +1189,66 @@ func CreateOverwrites(ctx context.Context, cmd registry.InteractionContext, user
 		}
 	}
 
-	// Panel-specific custom teams — per-team permissions
-	if panel != nil {
-		panelTeamIds, err := dbclient.Client.PanelTeams.GetTeamIds(ctx, panel.PanelId)
-		if err != nil {
-			return nil, err
-		}
+	// Custom teams — per-team permissions
+	teamOverwrites, err := BuildTeamOverwrites(ctx, cmd.Worker(), teamIds...)
+	if err != nil {
+		return nil, err
+	}
 
-		if len(panelTeamIds) > 0 {
-			teamPermsMap, err := dbclient.Client.SupportTeamPermissions.GetForTeams(ctx, panelTeamIds)
-			if err != nil {
-				return nil, err
-			}
+	overwrites = append(overwrites, teamOverwrites...)
 
-			for _, teamId := range panelTeamIds {
-				perms, ok := teamPermsMap[teamId]
-				if !ok {
-					perms = database.SupportTeamPermissions{
-						AddReactions:           true,
-						SendMessages:           true,
-						SendTTSMessages:        true,
-						EmbedLinks:             true,
-						AttachFiles:            true,
-						MentionEveryone:        false,
-						UseExternalEmojis:      true,
-						UseApplicationCommands: true,
-						UseExternalStickers:    true,
-						SendVoiceMessages:      true,
-					}
-				}
+	return overwrites, nil
+}
 
-				userIds, err := dbclient.Client.SupportTeamMembers.Get(ctx, teamId)
-				if err != nil {
-					return nil, err
-				}
+// BuildTeamOverwrites returns the overwrites giving the members and roles of the support teams access to a ticket,
+// with each team's configured permissions
+func BuildTeamOverwrites(ctx context.Context, worker *worker.Context, teamIds ...int) ([]channel.PermissionOverwrite, error) {
+	if len(teamIds) == 0 {
+		return nil, nil
+	}
 
-				roleIds, err := dbclient.Client.SupportTeamRoles.Get(ctx, teamId)
-				if err != nil {
-					return nil, err
-				}
+	teamPermsMap, err := dbclient.Client.SupportTeamPermissions.GetForTeams(ctx, teamIds)
+	if err != nil {
+		return nil, err
+	}
 
-				for _, userId := range userIds {
-					if userId == cmd.Worker().BotId {
-						continue
-					}
-					overwrites = append(overwrites, BuildStaffUserOverwrite(userId, perms))
-				}
+	var overwrites []channel.PermissionOverwrite
+	for _, teamId := range teamIds {
+		perms, ok := teamPermsMap[teamId]
+		if !ok {
+			perms = database.SupportTeamPermissions{
+				AddReactions:           true,
+				SendMessages:           true,
+				SendTTSMessages:        true,
+				EmbedLinks:             true,
+				AttachFiles:            true,
+				MentionEveryone:        false,
+				UseExternalEmojis:      true,
+				UseApplicationCommands: true,
+				UseExternalStickers:    true,
+				SendVoiceMessages:      true,
+			}
+		}
 
-				for _, roleId := range roleIds {
-					overwrites = append(overwrites, BuildStaffRoleOverwrite(roleId, perms))
-				}
+		userIds, err := dbclient.Client.SupportTeamMembers.Get(ctx, teamId)
+		if err != nil {
+			return nil, err
+		}
+
+		roleIds, err := dbclient.Client.SupportTeamRoles.Get(ctx, teamId)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, userId := range userIds {
+			if userId == worker.BotId {
+				continue
 			}
+			overwrites = append(overwrites, BuildStaffUserOverwrite(userId, perms))
+		}
+
+		for _, roleId := range roleIds {
+			overwrites = append(overwrites, BuildStaffRoleOverwrite(roleId, perms))
 		}
 	}
 
@@ -1171,8 +1350,18 @@ func GenerateChannelName(ctx context.Context, worker *worker.Context, panel *dat
 	// Create ticket name
 	var name string
 
+	var customNamingScheme *string
+	if panel != nil {
+		customNamingScheme = panel.NamingScheme
+	}
+
+	// Routing rules can override the panel's naming scheme
+	if routing, ok, err := dbclient.Storage.TicketRouting.Get(ctx, guildId, ticketId); err == nil && ok && routing.NamingScheme != "" {
+		customNamingScheme = &routing.NamingScheme
+	}
+
 	// Use server default naming scheme
-	if panel == nil || panel.NamingScheme == nil {
+	if customNamingScheme == nil {
 		namingScheme, err := dbclient.Client.NamingScheme.Get(ctx, guildId)
 		if err != nil {
 			return "", err
@@ -1192,7 +1381,7 @@ func GenerateChannelName(ctx context.Context, worker *worker.Context, panel *dat
 		}
 	} else {
 		var err error
-		name, err = DoSubstitutionsWithParams(worker, *panel.NamingScheme, openerId, guildId, []Substitutor{
+		name, err = DoSubstitutionsWithParams(worker, *customNamingScheme, openerId, guildId, []Substitutor{
 			// %id%
 			NewSubstitutor("id", false, false, func(user user.User, member member.Member) string {
 				return strconv.Itoa(ticketId)
@@ -1207,6 +1396,8 @@ func GenerateChannelName(ctx context.Context, worker *worker.Context, panel *dat
 					return "unclaimed"
 				}
 				return "claimed"
+			}).WithCondition(func(user user.User, member member.Member) bool {
+				return claimer != nil
 			}),
 			// %claim_indicator%
 			NewSubstitutor("claim_indicator", false, false, func(user user.User, member member.Member) string {
@@ -1215,6 +1406,22 @@ func GenerateChannelName(ctx context.Context, worker *worker.Context, panel *dat
 				}
 				return "🟢"
 			}),
+			// %escalation_level%
+			NewSubstitutor("escalation_level", false, false, func(user user.User, member member.Member) string {
+				escalation, err := dbclient.Storage.TicketEscalations.Get(ctx, guildId, ticketId)
+				if err != nil {
+					return "0"
+				}
+				return strconv.Itoa(escalation.Level)
+			}),
+			// %priority%
+			NewSubstitutor("priority", false, false, func(user user.User, member member.Member) string {
+				priority, err := dbclient.Storage.TicketPriorities.Get(ctx, guildId, ticketId)
+				if err != nil {
+					return string(storage.PriorityNormal)
+				}
+				return string(priority)
+			}),
 			// %claimed_by%
 			NewSubstitutor("claimed_by", false, false, func(user user.User, member member.Member) string {
 				if claimer != nil {
@@ -1312,6 +1519,8 @@ func GenerateChannelName(ctx context.Context, worker *worker.Context, panel *dat
 				}
 				return FormatPlainDate(t, format)
 			}),
+			// %form:LABEL% or %form:CUSTOM_ID%
+			NewFormAnswerSubstitutor(ctx, guildId, ticketId),
 		})
 
 		if err != nil {
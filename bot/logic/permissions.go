@@ -51,7 +51,38 @@ func HasPermissionForTicket(ctx context.Context, worker *worker.Context, ticket
 		panel = &tmp
 	}
 
-	return HasPermissionForPanel(ctx, worker, ticket.GuildId, panel, userId)
+	// The ticket may have been routed to different teams than its panel's, or escalated to further teams
+	teamIds, err := TicketAccessTeamIds(ctx, ticket, panel)
+	if err != nil {
+		return false, err
+	}
+
+	return HasPermissionForTeams(ctx, worker, ticket.GuildId, panel, teamIds, userId)
+}
+
+// HasPermissionForTeams checks if a user is an admin, a member of any of the teams, or a member of the default team
+// if there is no panel or the panel includes it
+func HasPermissionForTeams(ctx context.Context, worker *worker.Context, guildId uint64, panel *database.Panel, teamIds []int, userId uint64) (bool, error) {
+	isAdmin, err := IsAdminForGuild(ctx, worker, guildId, userId)
+	if err != nil || isAdmin {
+		return isAdmin, err
+	}
+
+	member, err := worker.GetGuildMember(guildId, userId)
+	if err != nil {
+		return false, err
+	}
+
+	inDefaultTeam, memberTeamIds, err := GetMemberTeamsWithMember(ctx, guildId, userId, member)
+	if err != nil {
+		return false, err
+	}
+
+	if inDefaultTeam && (panel == nil || panel.WithDefaultTeam) {
+		return true, nil
+	}
+
+	return utils.HasIntersection(teamIds, memberTeamIds), nil
 }
 
 // IsAdminForGuild checks if a user is a guild owner or admin
@@ -247,19 +278,15 @@ func FilterStaffMembers(
 		return nil, err
 	}
 
-	var teamUsers, teamRoles []uint64
-	if panel != nil {
-		// Check whether user is part of a team directly
-		teamUsers, err = dbclient.Client.SupportTeamMembers.GetAllSupportMembersForPanel(ctx, panel.PanelId)
-		if err != nil {
-			return nil, err
-		}
+	// The ticket may have been routed or escalated to teams other than the panel's
+	teamIds, err := TicketAccessTeamIds(ctx, ticket, panel)
+	if err != nil {
+		return nil, err
+	}
 
-		// Check whether user has any of the roles
-		teamRoles, err = dbclient.Client.SupportTeamRoles.GetAllSupportRolesForPanel(ctx, panel.PanelId)
-		if err != nil {
-			return nil, err
-		}
+	teamUsers, teamRoles, err := GetTeamUsersAndRoles(ctx, teamIds)
+	if err != nil {
+		return nil, err
 	}
 
 	group, _ := errgroup.WithContext(ctx)
@@ -402,3 +429,24 @@ func GetMemberTeamsWithMember(ctx context.Context, guildId, userId uint64, membe
 
 	return defaultSupportTeam, teamIds.Collect(), nil
 }
+
+// GetTeamUsersAndRoles returns the users and roles that are members of any of the given support teams
+func GetTeamUsersAndRoles(ctx context.Context, teamIds []int) ([]uint64, []uint64, error) {
+	var users, roles []uint64
+	for _, teamId := range teamIds {
+		teamUsers, err := dbclient.Client.SupportTeamMembers.Get(ctx, teamId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		teamRoles, err := dbclient.Client.SupportTeamRoles.Get(ctx, teamId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		users = append(users, teamUsers...)
+		roles = append(roles, teamRoles...)
+	}
+
+	return users, roles, nil
+}
@@ -0,0 +1,215 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/channel"
+	"github.com/TicketsBot-cloud/gdl/rest"
+	"github.com/TicketsBot-cloud/gdl/rest/request"
+	"github.com/TicketsBot-cloud/worker"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+)
+
+var PriorityNames = map[storage.TicketPriority]string{
+	storage.PriorityLow:    "Low",
+	storage.PriorityNormal: "Normal",
+	storage.PriorityHigh:   "High",
+	storage.PriorityUrgent: "Urgent",
+}
+
+// ParsePriority parses a priority name, ignoring case and surrounding whitespace
+func ParsePriority(s string) (storage.TicketPriority, bool) {
+	priority := storage.TicketPriority(strings.ToLower(strings.TrimSpace(s)))
+	return priority, priority.IsValid()
+}
+
+// DefaultTicketPriority returns the priority a new ticket should be opened with: the answer to the guild's priority
+// form question if it names a priority, otherwise the panel's default
+func DefaultTicketPriority(settings storage.PrioritySettings, panel *database.Panel, formData map[database.FormInput]string) storage.TicketPriority {
+	if settings.FormQuestion != "" {
+		for input, answer := range formData {
+			if !strings.EqualFold(input.Label, settings.FormQuestion) {
+				continue
+			}
+
+			if priority, ok := ParsePriority(answer); ok {
+				return priority
+			}
+		}
+	}
+
+	if panel != nil {
+		if priority, ok := settings.PanelDefaults[panel.PanelId]; ok && priority.IsValid() {
+			return priority
+		}
+	}
+
+	return storage.PriorityNormal
+}
+
+// ChangeTicketPriority sets the ticket's priority, renaming the channel if its naming scheme contains the priority
+// and moving it within its category if the guild has reordering enabled
+func ChangeTicketPriority(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, priority storage.TicketPriority) error {
+	if ticket.ChannelId == nil {
+		return dbclient.Storage.TicketPriorities.Set(ctx, ticket.GuildId, ticket.Id, priority)
+	}
+
+	var panel *database.Panel
+	if ticket.PanelId != nil {
+		tmp, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
+		if err != nil {
+			return err
+		}
+
+		if tmp.PanelId != 0 {
+			panel = &tmp
+		}
+	}
+
+	claimer, err := dbclient.Client.TicketClaims.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return err
+	}
+
+	ch, err := cmd.Worker().GetChannel(*ticket.ChannelId)
+	if err != nil {
+		return err
+	}
+
+	// The old name must be generated before the priority is stored, as it may contain the priority
+	oldChannelName, _ := GenerateChannelName(ctx, cmd.Worker(), panel, ticket.GuildId, ticket.Id, ticket.UserId, utils.NilIfZero(claimer))
+
+	if err := dbclient.Storage.TicketPriorities.Set(ctx, ticket.GuildId, ticket.Id, priority); err != nil {
+		return err
+	}
+
+	// Don't overwrite a name that has been set manually
+	if ch.Name == oldChannelName {
+		newChannelName, err := GenerateChannelName(ctx, cmd.Worker(), panel, ticket.GuildId, ticket.Id, ticket.UserId, utils.NilIfZero(claimer))
+		if err != nil {
+			return err
+		}
+
+		if newChannelName != oldChannelName {
+			allowed, err := redis.TakeRenameRatelimit(ctx, *ticket.ChannelId)
+			if err != nil {
+				return err
+			}
+
+			if allowed {
+				reasonCtx := request.WithAuditReason(ctx, fmt.Sprintf("Changed priority of ticket %d to %s", ticket.Id, priority))
+				if ch, err = cmd.Worker().ModifyChannel(reasonCtx, *ticket.ChannelId, rest.ModifyChannelData{Name: newChannelName}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if ticket.IsThread {
+		return nil
+	}
+
+	settings, err := dbclient.Storage.PrioritySettings.Get(ctx, ticket.GuildId)
+	if err != nil || !settings.Reorder {
+		return err
+	}
+
+	return ReorderTicketChannels(ctx, cmd.Worker(), ticket.GuildId, ch)
+}
+
+// ReorderTicketChannels sorts the ticket channels in the category containing ch by priority, highest first, after
+// any channels that are not tickets. ch is passed in as it may not have reached the cache yet.
+func ReorderTicketChannels(ctx context.Context, worker *worker.Context, guildId uint64, ch channel.Channel) error {
+	if ch.ParentId.IsNull || ch.ParentId.Value == 0 {
+		return nil
+	}
+
+	categoryId := ch.ParentId.Value
+
+	guildChannels, err := worker.GetGuildChannels(guildId)
+	if err != nil {
+		return err
+	}
+
+	channels := []channel.Channel{ch}
+	for _, guildChannel := range guildChannels {
+		if guildChannel.Id != ch.Id && guildChannel.ParentId.Value == categoryId && guildChannel.Type == channel.ChannelTypeGuildText {
+			channels = append(channels, guildChannel)
+		}
+	}
+
+	if len(channels) < 2 {
+		return nil
+	}
+
+	tickets, err := dbclient.Client.Tickets.GetGuildOpenTicketsExcludeThreads(ctx, guildId)
+	if err != nil {
+		return err
+	}
+
+	priorities, err := dbclient.Storage.TicketPriorities.GetOpen(ctx, guildId)
+	if err != nil {
+		return err
+	}
+
+	ranks := make(map[uint64]int)
+	for _, ticket := range tickets {
+		if ticket.ChannelId == nil {
+			continue
+		}
+
+		priority, ok := priorities[ticket.Id]
+		if !ok {
+			priority = storage.PriorityNormal
+		}
+
+		ranks[*ticket.ChannelId] = priority.Rank()
+	}
+
+	// Channels that aren't tickets are ranked above urgent tickets, so that they are kept at the top
+	rank := func(channelId uint64) int {
+		if rank, ok := ranks[channelId]; ok {
+			return rank
+		}
+
+		return len(storage.Priorities)
+	}
+
+	sort.SliceStable(channels, func(i, j int) bool {
+		if channels[i].Position != channels[j].Position {
+			return channels[i].Position < channels[j].Position
+		}
+
+		return channels[i].Id < channels[j].Id
+	})
+
+	basePosition := channels[0].Position
+
+	sort.SliceStable(channels, func(i, j int) bool {
+		return rank(channels[i].Id) > rank(channels[j].Id)
+	})
+
+	var positions []rest.Position
+	for i, ch := range channels {
+		if ch.Position != basePosition+i {
+			positions = append(positions, rest.Position{
+				ChannelId: ch.Id,
+				Position:  basePosition + i,
+			})
+		}
+	}
+
+	if len(positions) == 0 {
+		return nil
+	}
+
+	return worker.ModifyGuildChannelPositions(guildId, positions)
+}
@@ -0,0 +1,176 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/channel"
+	"github.com/TicketsBot-cloud/gdl/objects/channel/embed"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+var ErrInvalidTimeRange = errors.New("invalid time range")
+
+// RoutingInput is what routing rules are checked against when a ticket is opened
+type RoutingInput struct {
+	PanelId int
+	Answers map[database.FormInput]string
+	RoleIds []uint64
+	Locale  string
+	Time    time.Time
+}
+
+func NewRoutingInput(cmd registry.InteractionContext, panel *database.Panel, formData map[database.FormInput]string) RoutingInput {
+	input := RoutingInput{
+		Answers: formData,
+		Locale:  cmd.InteractionMetadata().Locale,
+		Time:    time.Now(),
+	}
+
+	if panel != nil {
+		input.PanelId = panel.PanelId
+	}
+
+	if member := cmd.InteractionMetadata().Member; member != nil {
+		input.RoleIds = member.Roles
+	}
+
+	return input
+}
+
+// MatchRoutingRule returns the first rule whose conditions are all met
+func MatchRoutingRule(rules []storage.RoutingRule, input RoutingInput) (storage.RoutingRule, bool) {
+	for _, rule := range rules {
+		if routingRuleMatches(rule, input) {
+			return rule, true
+		}
+	}
+
+	return storage.RoutingRule{}, false
+}
+
+func routingRuleMatches(rule storage.RoutingRule, input RoutingInput) bool {
+	if rule.PanelId != 0 && rule.PanelId != input.PanelId {
+		return false
+	}
+
+	if rule.Question != "" {
+		answered := false
+		for formInput, answer := range input.Answers {
+			if strings.EqualFold(formInput.Label, rule.Question) && formAnswerMatches(answer, rule.Answers) {
+				answered = true
+				break
+			}
+		}
+
+		if !answered {
+			return false
+		}
+	}
+
+	if rule.RoleId != 0 && !utils.Contains(input.RoleIds, rule.RoleId) {
+		return false
+	}
+
+	// A rule for a language, such as "en", matches each of its regional locales, such as "en-US"
+	if rule.Locale != "" {
+		language, _, _ := strings.Cut(input.Locale, "-")
+		if !strings.EqualFold(rule.Locale, input.Locale) && !strings.EqualFold(rule.Locale, language) {
+			return false
+		}
+	}
+
+	if rule.TimeRange != nil && !timeRangeContains(*rule.TimeRange, input.Time) {
+		return false
+	}
+
+	return true
+}
+
+func timeRangeContains(timeRange storage.RoutingTimeRange, t time.Time) bool {
+	location, err := time.LoadLocation(timeRange.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	t = t.In(location)
+	minutes := t.Hour()*60 + t.Minute()
+
+	if timeRange.Start <= timeRange.End {
+		return minutes >= timeRange.Start && minutes < timeRange.End
+	}
+
+	return minutes >= timeRange.Start || minutes < timeRange.End
+}
+
+// ParseRoutingTimeRange parses a time range in the form `09:00-17:00`, in the given IANA timezone
+func ParseRoutingTimeRange(s, timezone string) (storage.RoutingTimeRange, error) {
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return storage.RoutingTimeRange{}, ErrInvalidTimeRange
+	}
+
+	startRaw, endRaw, ok := strings.Cut(strings.ReplaceAll(s, " ", ""), "-")
+	if !ok {
+		return storage.RoutingTimeRange{}, ErrInvalidTimeRange
+	}
+
+	start, err := time.Parse("15:04", startRaw)
+	if err != nil {
+		return storage.RoutingTimeRange{}, ErrInvalidTimeRange
+	}
+
+	end, err := time.Parse("15:04", endRaw)
+	if err != nil {
+		return storage.RoutingTimeRange{}, ErrInvalidTimeRange
+	}
+
+	return storage.RoutingTimeRange{
+		Start:    start.Hour()*60 + start.Minute(),
+		End:      end.Hour()*60 + end.Minute(),
+		Timezone: timezone,
+	}, nil
+}
+
+// TicketTeamIds returns the support teams that have access to the ticket: those of the routing rule it matched when
+// it was opened, if the rule set any, otherwise those of its panel
+func TicketTeamIds(ctx context.Context, ticket database.Ticket, panel *database.Panel) ([]int, error) {
+	routing, ok, err := dbclient.Storage.TicketRouting.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok && len(routing.TeamIds) > 0 {
+		return routing.TeamIds, nil
+	}
+
+	if panel == nil {
+		return nil, nil
+	}
+
+	return dbclient.Client.PanelTeams.GetTeamIds(ctx, panel.PanelId)
+}
+
+// reportRoutingDryRun tells admins which rule a ticket would have been routed by if dry run mode was disabled
+func reportRoutingDryRun(cmd registry.CommandContext, settings storage.RoutingSettings, ticketId int, ch channel.Channel, rule storage.RoutingRule, matched bool) error {
+	var msgEmbed *embed.Embed
+	if matched {
+		msgEmbed = utils.BuildEmbed(cmd, customisation.Orange, i18n.TitleRouting, i18n.MessageRoutingDryRunMatched, nil, ticketId, ch.Mention(), rule.Name)
+	} else {
+		msgEmbed = utils.BuildEmbed(cmd, customisation.Orange, i18n.TitleRouting, i18n.MessageRoutingDryRunNoMatch, nil, ticketId, ch.Mention())
+	}
+
+	_, err := cmd.Worker().CreateMessageEmbed(settings.DryRunChannelId, msgEmbed)
+	return err
+}
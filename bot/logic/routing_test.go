@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchRoutingRule(t *testing.T) {
+	rules := []storage.RoutingRule{
+		{Name: "billing", PanelId: 1, Question: "Category", Answers: []string{"billing"}},
+		{Name: "german", Locale: "de"},
+		{Name: "vip", RoleId: 100},
+	}
+
+	input := RoutingInput{
+		PanelId: 1,
+		Answers: map[database.FormInput]string{{Id: 1, Label: "category"}: "Billing"},
+	}
+
+	rule, ok := MatchRoutingRule(rules, input)
+	require.True(t, ok)
+	require.Equal(t, "billing", rule.Name)
+
+	// Rules are checked in order, and conditions are scoped to the panel
+	input.PanelId = 2
+	input.Locale = "de"
+	input.RoleIds = []uint64{100}
+
+	rule, ok = MatchRoutingRule(rules, input)
+	require.True(t, ok)
+	require.Equal(t, "german", rule.Name)
+
+	_, ok = MatchRoutingRule(rules, RoutingInput{Locale: "en-US"})
+	require.False(t, ok)
+}
+
+func TestRoutingTimeRange(t *testing.T) {
+	timeRange, err := ParseRoutingTimeRange("22:00-06:00", "")
+	require.NoError(t, err)
+	require.Equal(t, storage.RoutingTimeRange{Start: 22 * 60, End: 6 * 60, Timezone: "UTC"}, timeRange)
+
+	require.True(t, timeRangeContains(timeRange, time.Date(2026, 10, 17, 23, 30, 0, 0, time.UTC)))
+	require.True(t, timeRangeContains(timeRange, time.Date(2026, 10, 17, 5, 59, 0, 0, time.UTC)))
+	require.False(t, timeRangeContains(timeRange, time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)))
+
+	_, err = ParseRoutingTimeRange("9am-5pm", "")
+	require.ErrorIs(t, err, ErrInvalidTimeRange)
+
+	_, err = ParseRoutingTimeRange("09:00-17:00", "Not/AZone")
+	require.ErrorIs(t, err, ErrInvalidTimeRange)
+}
@@ -0,0 +1,136 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/channel/embed"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction/component"
+	"github.com/TicketsBot-cloud/gdl/rest"
+	"github.com/TicketsBot-cloud/worker"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/scheduler"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+// TimerKindScheduledClose is the kind of the timer that closes a ticket after the delay given to /close. The handler
+// is registered by the messagequeue package, which is able to build an autoclose command context.
+const TimerKindScheduledClose = "scheduled_close"
+
+const maxScheduledCloseDelay = time.Hour * 24 * 30
+
+type ScheduledCloseTimerPayload struct {
+	GuildId  uint64  `json:"guild_id"`
+	TicketId int     `json:"ticket_id"`
+	UserId   uint64  `json:"user_id"`
+	Reason   *string `json:"reason,omitempty"`
+}
+
+func scheduledCloseTimerId(guildId uint64, ticketId int) string {
+	return fmt.Sprintf("%d:%d", guildId, ticketId)
+}
+
+// ScheduleCloseWithReply schedules the ticket to be closed after the delay, replying with a countdown that has a
+// button to cancel the close
+func ScheduleCloseWithReply(ctx context.Context, cmd registry.CommandContext, reason *string, delayRaw string) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, cmd.ChannelId(), cmd.GuildId())
+	if err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	if ticket.Id == 0 || ticket.GuildId != cmd.GuildId() {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	if !utils.CanClose(ctx, cmd, ticket) {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageCloseNoPermission)
+		return
+	}
+
+	if reason != nil && len(*reason) > 255 {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageCloseReasonTooLong)
+		return
+	}
+
+	delay, err := utils.ParseDuration(delayRaw)
+	if err != nil || delay <= 0 || delay > maxScheduledCloseDelay {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageScheduledCloseInvalidDelay)
+		return
+	}
+
+	closeAt := time.Now().Add(delay)
+	payload := ScheduledCloseTimerPayload{
+		GuildId:  ticket.GuildId,
+		TicketId: ticket.Id,
+		UserId:   cmd.UserId(),
+		Reason:   reason,
+	}
+
+	if err := scheduler.Schedule(ctx, TimerKindScheduledClose, scheduledCloseTimerId(ticket.GuildId, ticket.Id), closeAt, payload); err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	var msgEmbed *embed.Embed
+	if reason == nil {
+		msgEmbed = utils.BuildEmbed(cmd, customisation.Orange, i18n.TitleClose, i18n.MessageScheduledClose, nil, cmd.UserId(), fmt.Sprintf("<t:%d:R>", closeAt.Unix()))
+	} else {
+		msgEmbed = utils.BuildEmbed(cmd, customisation.Orange, i18n.TitleClose, i18n.MessageScheduledCloseWithReason, nil, cmd.UserId(), fmt.Sprintf("<t:%d:R>", closeAt.Unix()), strings.ReplaceAll(*reason, "`", "\\`"))
+	}
+
+	if _, err := cmd.ReplyWith(command.MessageResponse{
+		Embeds: []*embed.Embed{msgEmbed},
+		Components: []component.Component{
+			component.BuildActionRow(
+				component.BuildButton(component.Button{
+					Label:    cmd.GetMessage(i18n.MessageScheduledCloseCancel),
+					CustomId: "scheduled_close_cancel",
+					Style:    component.ButtonStyleSecondary,
+					Emoji:    utils.BuildEmoji("❌"),
+				}),
+			),
+		},
+	}); err != nil {
+		cmd.HandleError(err)
+	}
+}
+
+// CancelScheduledClose cancels the ticket's scheduled close, returning true if one was pending
+func CancelScheduledClose(ctx context.Context, guildId uint64, ticketId int) (bool, error) {
+	return scheduler.Cancel(ctx, TimerKindScheduledClose, scheduledCloseTimerId(guildId, ticketId))
+}
+
+// CancelScheduledCloseOnReply cancels the ticket's scheduled close when the opener sends a message, letting the ticket know
+func CancelScheduledCloseOnReply(ctx context.Context, worker *worker.Context, ticket database.Ticket) error {
+	cancelled, err := CancelScheduledClose(ctx, ticket.GuildId, ticket.Id)
+	if err != nil || !cancelled || ticket.ChannelId == nil {
+		return err
+	}
+
+	premiumTier, err := utils.PremiumClient.GetTierByGuildId(ctx, ticket.GuildId, true, worker.Token, worker.RateLimiter)
+	if err != nil {
+		return err
+	}
+
+	msgEmbed := utils.BuildEmbedRaw(
+		customisation.GetColourOrDefault(ctx, ticket.GuildId, customisation.Green),
+		i18n.GetMessageFromGuild(ticket.GuildId, i18n.TitleClose),
+		i18n.GetMessageFromGuild(ticket.GuildId, i18n.MessageScheduledCloseCancelledByReply, ticket.UserId),
+		nil,
+		premiumTier,
+	)
+
+	_, err = worker.CreateMessageComplex(*ticket.ChannelId, rest.CreateMessageData{
+		Embeds: []*embed.Embed{msgEmbed},
+	})
+	return err
+}
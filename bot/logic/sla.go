@@ -0,0 +1,352 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/channel/embed"
+	"github.com/TicketsBot-cloud/gdl/objects/channel/message"
+	"github.com/TicketsBot-cloud/gdl/rest"
+	"github.com/TicketsBot-cloud/worker/bot/cache"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/bot/scheduler"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+const (
+	timerKindSlaWarning = "sla_warning"
+	timerKindSlaBreach  = "sla_breach"
+
+	// Keep the notice well within the message content length limit
+	maxSlaMentions = 50
+)
+
+type slaTimerPayload struct {
+	GuildId  uint64            `json:"guild_id"`
+	TicketId int               `json:"ticket_id"`
+	Target   storage.SlaTarget `json:"target"`
+	Deadline time.Time         `json:"deadline"`
+}
+
+func init() {
+	scheduler.Register(timerKindSlaWarning, handleSlaTimer(false))
+	scheduler.Register(timerKindSlaBreach, handleSlaTimer(true))
+}
+
+func slaTimerId(guildId uint64, ticketId int, target storage.SlaTarget) string {
+	return fmt.Sprintf("%d:%d:%s", guildId, ticketId, target)
+}
+
+// StartSlaTimers schedules the warning and breach timers for each target set on the ticket's panel
+func StartSlaTimers(ctx context.Context, ticket database.Ticket) error {
+	if ticket.PanelId == nil {
+		return nil
+	}
+
+	policy, ok, err := dbclient.Storage.SlaPolicies.Get(ctx, ticket.GuildId, *ticket.PanelId)
+	if err != nil || !ok {
+		return err
+	}
+
+	targets := map[storage.SlaTarget]time.Duration{
+		storage.SlaTargetFirstResponse: policy.FirstResponse,
+		storage.SlaTargetResolution:    policy.Resolution,
+	}
+
+	for target, duration := range targets {
+		if duration <= 0 {
+			continue
+		}
+
+		payload := slaTimerPayload{
+			GuildId:  ticket.GuildId,
+			TicketId: ticket.Id,
+			Target:   target,
+			Deadline: ticket.OpenTime.Add(duration),
+		}
+
+		id := slaTimerId(ticket.GuildId, ticket.Id, target)
+		if err := scheduler.Schedule(ctx, timerKindSlaBreach, id, payload.Deadline, payload); err != nil {
+			return err
+		}
+
+		if warnAt := payload.Deadline.Add(-policy.WarnBefore); policy.WarnBefore > 0 && warnAt.After(time.Now()) {
+			if err := scheduler.Schedule(ctx, timerKindSlaWarning, id, warnAt, payload); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// OnSlaFirstResponse stops the first response timers, counting the target as met by the responder if it had not
+// already been breached
+func OnSlaFirstResponse(ctx context.Context, guildId uint64, ticketId int, responderId uint64) error {
+	return stopSlaTarget(ctx, guildId, ticketId, storage.SlaTargetFirstResponse, &responderId)
+}
+
+// StopSlaTimers stops all of the ticket's timers once it has been closed, counting the resolution target as met
+// by the claimer if it had not already been breached. A first response target that was never responded to is
+// not counted either way.
+func StopSlaTimers(ctx context.Context, ticket database.Ticket) error {
+	if err := stopSlaTarget(ctx, ticket.GuildId, ticket.Id, storage.SlaTargetFirstResponse, nil); err != nil {
+		return err
+	}
+
+	claimer, err := dbclient.Client.TicketClaims.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return err
+	}
+
+	return stopSlaTarget(ctx, ticket.GuildId, ticket.Id, storage.SlaTargetResolution, &claimer)
+}
+
+// stopSlaTarget cancels the target's timers, recording the target as met if userId is not nil and the breach
+// timer had not yet fired
+func stopSlaTarget(ctx context.Context, guildId uint64, ticketId int, target storage.SlaTarget, userId *uint64) error {
+	id := slaTimerId(guildId, ticketId, target)
+
+	if _, err := scheduler.Cancel(ctx, timerKindSlaWarning, id); err != nil {
+		return err
+	}
+
+	pending, err := scheduler.Cancel(ctx, timerKindSlaBreach, id)
+	if err != nil {
+		return err
+	}
+
+	if pending && userId != nil {
+		return dbclient.Storage.SlaStats.Record(ctx, guildId, ticketId, *userId, target, false)
+	}
+
+	return nil
+}
+
+// PauseSlaTimers cancels the ticket's pending SLA timers, returning the time that was left until each target's
+// deadline so that the timers can later be restarted with ResumeSlaTimers. On error, the timers that were already
+// cancelled are still returned.
+func PauseSlaTimers(ctx context.Context, guildId uint64, ticketId int) (map[storage.SlaTarget]time.Duration, error) {
+	remaining := make(map[storage.SlaTarget]time.Duration)
+	for _, target := range []storage.SlaTarget{storage.SlaTargetFirstResponse, storage.SlaTargetResolution} {
+		id := slaTimerId(guildId, ticketId, target)
+
+		deadline, pending, err := redis.GetTimerFireTime(ctx, timerKindSlaBreach, id)
+		if err != nil {
+			return remaining, err
+		}
+
+		if !pending {
+			continue
+		}
+
+		if _, err := scheduler.Cancel(ctx, timerKindSlaWarning, id); err != nil {
+			return remaining, err
+		}
+
+		// If the timer fired in the meantime, the target has already been breached
+		cancelled, err := scheduler.Cancel(ctx, timerKindSlaBreach, id)
+		if err != nil {
+			return remaining, err
+		}
+
+		if cancelled {
+			left := time.Until(deadline)
+			if left < 0 {
+				left = 0
+			}
+
+			remaining[target] = left
+		}
+	}
+
+	return remaining, nil
+}
+
+// ResumeSlaTimers restarts timers paused by PauseSlaTimers, with each deadline pushed back by the time spent paused
+func ResumeSlaTimers(ctx context.Context, ticket database.Ticket, remaining map[storage.SlaTarget]time.Duration) error {
+	if len(remaining) == 0 {
+		return nil
+	}
+
+	var warnBefore time.Duration
+	if ticket.PanelId != nil {
+		policy, ok, err := dbclient.Storage.SlaPolicies.Get(ctx, ticket.GuildId, *ticket.PanelId)
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			warnBefore = policy.WarnBefore
+		}
+	}
+
+	for target, left := range remaining {
+		payload := slaTimerPayload{
+			GuildId:  ticket.GuildId,
+			TicketId: ticket.Id,
+			Target:   target,
+			Deadline: time.Now().Add(left),
+		}
+
+		id := slaTimerId(ticket.GuildId, ticket.Id, target)
+		if err := scheduler.Schedule(ctx, timerKindSlaBreach, id, payload.Deadline, payload); err != nil {
+			return err
+		}
+
+		if warnAt := payload.Deadline.Add(-warnBefore); warnBefore > 0 && warnAt.After(time.Now()) {
+			if err := scheduler.Schedule(ctx, timerKindSlaWarning, id, warnAt, payload); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func handleSlaTimer(breach bool) scheduler.Handler {
+	return func(ctx context.Context, timer redis.Timer) error {
+		var payload slaTimerPayload
+		if err := json.Unmarshal(timer.Payload, &payload); err != nil {
+			return err
+		}
+
+		ticket, err := dbclient.Client.Tickets.Get(ctx, payload.TicketId, payload.GuildId)
+		if err != nil {
+			return err
+		}
+
+		if ticket.Id == 0 || !ticket.Open || ticket.ChannelId == nil {
+			return nil
+		}
+
+		claimer, err := dbclient.Client.TicketClaims.Get(ctx, ticket.GuildId, ticket.Id)
+		if err != nil {
+			return err
+		}
+
+		// Recording the breach is idempotent, so the notice can be retried if it fails to send
+		if breach {
+			if err := dbclient.Storage.SlaStats.Record(ctx, ticket.GuildId, ticket.Id, claimer, payload.Target, true); err != nil {
+				return err
+			}
+		}
+
+		return sendSlaNotice(ctx, ticket, claimer, payload, breach)
+	}
+}
+
+func sendSlaNotice(ctx context.Context, ticket database.Ticket, claimer uint64, payload slaTimerPayload, breach bool) error {
+	worker, err := BuildWorkerContext(ctx, ticket.GuildId, cache.Client)
+	if err != nil {
+		return err
+	}
+
+	premiumTier, err := utils.PremiumClient.GetTierByGuildId(ctx, ticket.GuildId, true, worker.Token, worker.RateLimiter)
+	if err != nil {
+		return err
+	}
+
+	var colour customisation.Colour
+	var title, content i18n.MessageId
+	if breach {
+		colour, title = customisation.Red, i18n.TitleSlaBreach
+		if payload.Target == storage.SlaTargetFirstResponse {
+			content = i18n.MessageSlaBreachFirstResponse
+		} else {
+			content = i18n.MessageSlaBreachResolution
+		}
+	} else {
+		colour, title = customisation.Orange, i18n.TitleSlaWarning
+		if payload.Target == storage.SlaTargetFirstResponse {
+			content = i18n.MessageSlaWarningFirstResponse
+		} else {
+			content = i18n.MessageSlaWarningResolution
+		}
+	}
+
+	msgEmbed := utils.BuildEmbedRaw(
+		customisation.GetColourOrDefault(ctx, ticket.GuildId, colour),
+		i18n.GetMessageFromGuild(ticket.GuildId, title),
+		i18n.GetMessageFromGuild(ticket.GuildId, content, fmt.Sprintf("<t:%d:R>", payload.Deadline.Unix())),
+		nil,
+		premiumTier,
+	)
+
+	mentions, allowedMentions, err := slaMentions(ctx, ticket, claimer)
+	if err != nil {
+		return err
+	}
+
+	_, err = worker.CreateMessageComplex(*ticket.ChannelId, rest.CreateMessageData{
+		Content:         mentions,
+		Embeds:          []*embed.Embed{msgEmbed},
+		AllowedMentions: allowedMentions,
+	})
+	return err
+}
+
+// slaMentions pings the claimer if there is one, or otherwise the support teams that can see the ticket
+func slaMentions(ctx context.Context, ticket database.Ticket, claimer uint64) (string, message.AllowedMention, error) {
+	if claimer != 0 {
+		return fmt.Sprintf("<@%d>", claimer), message.AllowedMention{Users: []uint64{claimer}}, nil
+	}
+
+	if ticket.PanelId == nil {
+		return "", message.AllowedMention{}, nil
+	}
+
+	panel, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
+	if err != nil {
+		return "", message.AllowedMention{}, err
+	}
+
+	teamIds, err := TicketAccessTeamIds(ctx, ticket, &panel)
+	if err != nil {
+		return "", message.AllowedMention{}, err
+	}
+
+	users, roles, err := GetTeamUsersAndRoles(ctx, teamIds)
+	if err != nil {
+		return "", message.AllowedMention{}, err
+	}
+
+	if panel.WithDefaultTeam {
+		defaultRoles, err := dbclient.Client.RolePermissions.GetSupportRoles(ctx, ticket.GuildId)
+		if err != nil {
+			return "", message.AllowedMention{}, err
+		}
+
+		roles = append(roles, defaultRoles...)
+	}
+
+	var mentions []string
+	var allowed message.AllowedMention
+	for _, roleId := range roles {
+		if len(mentions) == maxSlaMentions {
+			break
+		}
+
+		mentions = append(mentions, fmt.Sprintf("<@&%d>", roleId))
+		allowed.Roles = append(allowed.Roles, roleId)
+	}
+
+	for _, userId := range users {
+		if len(mentions) == maxSlaMentions {
+			break
+		}
+
+		mentions = append(mentions, fmt.Sprintf("<@%d>", userId))
+		allowed.Users = append(allowed.Users, userId)
+	}
+
+	return strings.Join(mentions, " "), allowed, nil
+}
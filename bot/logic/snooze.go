@@ -0,0 +1,277 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/premium"
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/channel/embed"
+	"github.com/TicketsBot-cloud/gdl/objects/channel/message"
+	"github.com/TicketsBot-cloud/gdl/rest"
+	"github.com/TicketsBot-cloud/gdl/rest/request"
+	"github.com/TicketsBot-cloud/worker"
+	"github.com/TicketsBot-cloud/worker/bot/cache"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/bot/scheduler"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+const (
+	timerKindUnsnooze = "unsnooze"
+
+	maxSnoozeDuration = time.Hour * 24 * 90
+)
+
+type unsnoozeTimerPayload struct {
+	GuildId  uint64 `json:"guild_id"`
+	TicketId int    `json:"ticket_id"`
+}
+
+func init() {
+	scheduler.Register(timerKindUnsnooze, handleUnsnoozeTimer)
+}
+
+func unsnoozeTimerId(guildId uint64, ticketId int) string {
+	return fmt.Sprintf("%d:%d", guildId, ticketId)
+}
+
+// SnoozeTicketWithReply parses when the ticket should wake up, given as a duration or a date, then snoozes the
+// ticket and replies to the user
+func SnoozeTicketWithReply(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, untilRaw string) {
+	hasPermission, err := HasPermissionForTicket(ctx, cmd.Worker(), ticket, cmd.UserId())
+	if err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	if !hasPermission {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageSnoozeNoPermission)
+		return
+	}
+
+	now := time.Now()
+	until, err := utils.ParseTimeOrDuration(untilRaw, now)
+	if err != nil || !until.After(now) || until.Sub(now) > maxSnoozeDuration {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageSnoozeInvalidTime)
+		return
+	}
+
+	if err := SnoozeTicket(ctx, cmd.Worker(), ticket, cmd.UserId(), until); err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	cmd.Reply(customisation.Green, i18n.TitleSnooze, i18n.MessageSnoozeSuccess, fmt.Sprintf("<t:%d:f>", until.Unix()), fmt.Sprintf("<t:%d:R>", until.Unix()))
+}
+
+// SnoozeTicket suspends the ticket's autoclose and SLA timers until the given time, moving the channel to the
+// guild's snoozed category if one is set. Snoozing a ticket that is already snoozed changes when it wakes up.
+func SnoozeTicket(ctx context.Context, worker *worker.Context, ticket database.Ticket, userId uint64, until time.Time) error {
+	snooze, alreadySnoozed, err := dbclient.Storage.TicketSnoozes.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return err
+	}
+
+	if !alreadySnoozed {
+		if _, err := CancelAutoClose(ctx, ticket.GuildId, ticket.Id); err != nil {
+			return err
+		}
+
+		// Any timers that were paused are restarted if the ticket can't be snoozed, as the time left on them would
+		// otherwise be lost
+		snooze.SlaRemaining, err = PauseSlaTimers(ctx, ticket.GuildId, ticket.Id)
+		if err != nil {
+			return errors.Join(err, ResumeSlaTimers(ctx, ticket, snooze.SlaRemaining))
+		}
+
+		snooze.PreviousCategoryId, err = moveToSnoozedCategory(ctx, worker, ticket)
+		if err != nil {
+			return errors.Join(err, ResumeSlaTimers(ctx, ticket, snooze.SlaRemaining))
+		}
+	}
+
+	snooze.Until = until
+	snooze.SnoozedBy = userId
+
+	if err := dbclient.Storage.TicketSnoozes.Set(ctx, ticket.GuildId, ticket.Id, snooze); err != nil {
+		if !alreadySnoozed {
+			return errors.Join(err, restoreSnoozedTicket(ctx, worker, ticket, snooze))
+		}
+
+		return err
+	}
+
+	payload := unsnoozeTimerPayload{
+		GuildId:  ticket.GuildId,
+		TicketId: ticket.Id,
+	}
+
+	return scheduler.Schedule(ctx, timerKindUnsnooze, unsnoozeTimerId(ticket.GuildId, ticket.Id), until, payload)
+}
+
+// UnsnoozeTicket restores a snoozed ticket and pings its claimer, or whoever snoozed it if it is unclaimed.
+// Returns false if the ticket was not snoozed. The snooze is only removed once the ticket has been restored, so that
+// the unsnooze timer is retried if any step fails.
+func UnsnoozeTicket(ctx context.Context, worker *worker.Context, ticket database.Ticket, premiumTier premium.PremiumTier) (bool, error) {
+	snooze, ok, err := dbclient.Storage.TicketSnoozes.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	if err := restoreSnoozedTicket(ctx, worker, ticket, snooze); err != nil {
+		return true, err
+	}
+
+	if ticket.ChannelId != nil {
+		if err := sendUnsnoozePing(ctx, worker, ticket, snooze, premiumTier); err != nil {
+			return true, err
+		}
+	}
+
+	if _, err := dbclient.Storage.TicketSnoozes.Delete(ctx, ticket.GuildId, ticket.Id); err != nil {
+		return true, err
+	}
+
+	_, err = scheduler.Cancel(ctx, timerKindUnsnooze, unsnoozeTimerId(ticket.GuildId, ticket.Id))
+	return true, err
+}
+
+// restoreSnoozedTicket restarts the ticket's paused SLA timers and moves its channel back to the category it was in
+// before it was snoozed. Both are safe to repeat.
+func restoreSnoozedTicket(ctx context.Context, worker *worker.Context, ticket database.Ticket, snooze storage.TicketSnooze) error {
+	if err := ResumeSlaTimers(ctx, ticket, snooze.SlaRemaining); err != nil {
+		return err
+	}
+
+	if ticket.ChannelId == nil || snooze.PreviousCategoryId == nil {
+		return nil
+	}
+
+	reasonCtx := request.WithAuditReason(context.Background(), fmt.Sprintf("Unsnoozed ticket %d", ticket.Id))
+	_, err := worker.ModifyChannel(reasonCtx, *ticket.ChannelId, rest.ModifyChannelData{ParentId: *snooze.PreviousCategoryId})
+	return err
+}
+
+func sendUnsnoozePing(ctx context.Context, worker *worker.Context, ticket database.Ticket, snooze storage.TicketSnooze, premiumTier premium.PremiumTier) error {
+	claimer, err := dbclient.Client.TicketClaims.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return err
+	}
+
+	pingUser := claimer
+	if pingUser == 0 {
+		pingUser = snooze.SnoozedBy
+	}
+
+	msgEmbed := utils.BuildEmbedRaw(
+		customisation.GetColourOrDefault(ctx, ticket.GuildId, customisation.Green),
+		i18n.GetMessageFromGuild(ticket.GuildId, i18n.TitleSnooze),
+		i18n.GetMessageFromGuild(ticket.GuildId, i18n.MessageSnoozeEnded, fmt.Sprintf("<t:%d:R>", snooze.Until.Unix())),
+		nil,
+		premiumTier,
+	)
+
+	msg, err := worker.CreateMessageComplex(*ticket.ChannelId, rest.CreateMessageData{
+		Content: fmt.Sprintf("<@%d>", pingUser),
+		Embeds:  []*embed.Embed{msgEmbed},
+		AllowedMentions: message.AllowedMention{
+			Users: []uint64{pingUser},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Restart the inactivity timer from now, so that the ticket is not autoclosed as soon as it wakes up
+	return dbclient.Client.TicketLastMessage.Set(ctx, ticket.GuildId, ticket.Id, msg.Id, pingUser, true)
+}
+
+// ClearTicketSnooze ends the ticket's snooze without notifying anyone, for when the ticket is being closed. Paused
+// SLA timers are restarted so that they are stopped and counted along with the ticket's other timers.
+func ClearTicketSnooze(ctx context.Context, ticket database.Ticket) error {
+	snooze, ok, err := dbclient.Storage.TicketSnoozes.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil || !ok {
+		return err
+	}
+
+	if err := ResumeSlaTimers(ctx, ticket, snooze.SlaRemaining); err != nil {
+		return err
+	}
+
+	if _, err := scheduler.Cancel(ctx, timerKindUnsnooze, unsnoozeTimerId(ticket.GuildId, ticket.Id)); err != nil {
+		return err
+	}
+
+	_, err = dbclient.Storage.TicketSnoozes.Delete(ctx, ticket.GuildId, ticket.Id)
+	return err
+}
+
+// moveToSnoozedCategory moves the ticket's channel into the guild's snoozed category, returning the category it was
+// previously in, or nil if it was not moved
+func moveToSnoozedCategory(ctx context.Context, worker *worker.Context, ticket database.Ticket) (*uint64, error) {
+	// Threads can't be moved between categories
+	if ticket.IsThread || ticket.ChannelId == nil {
+		return nil, nil
+	}
+
+	settings, err := dbclient.Storage.SnoozeSettings.Get(ctx, ticket.GuildId)
+	if err != nil || settings.CategoryId == 0 {
+		return nil, err
+	}
+
+	ch, err := worker.GetChannel(*ticket.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The channel can't be moved back out of the category if it had no category to begin with
+	if ch.ParentId.IsNull || ch.ParentId.Value == 0 || ch.ParentId.Value == settings.CategoryId {
+		return nil, nil
+	}
+
+	reasonCtx := request.WithAuditReason(context.Background(), fmt.Sprintf("Snoozed ticket %d", ticket.Id))
+	if _, err := worker.ModifyChannel(reasonCtx, *ticket.ChannelId, rest.ModifyChannelData{ParentId: settings.CategoryId}); err != nil {
+		return nil, err
+	}
+
+	return utils.Ptr(ch.ParentId.Value), nil
+}
+
+func handleUnsnoozeTimer(ctx context.Context, timer redis.Timer) error {
+	var payload unsnoozeTimerPayload
+	if err := json.Unmarshal(timer.Payload, &payload); err != nil {
+		return err
+	}
+
+	ticket, err := dbclient.Client.Tickets.Get(ctx, payload.TicketId, payload.GuildId)
+	if err != nil {
+		return err
+	}
+
+	if ticket.Id == 0 || !ticket.Open {
+		_, err := dbclient.Storage.TicketSnoozes.Delete(ctx, payload.GuildId, payload.TicketId)
+		return err
+	}
+
+	worker, err := BuildWorkerContext(ctx, ticket.GuildId, cache.Client)
+	if err != nil {
+		return err
+	}
+
+	premiumTier, err := utils.PremiumClient.GetTierByGuildId(ctx, ticket.GuildId, true, worker.Token, worker.RateLimiter)
+	if err != nil {
+		return err
+	}
+
+	_, err = UnsnoozeTicket(ctx, worker, ticket, premiumTier)
+	return err
+}
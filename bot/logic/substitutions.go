@@ -1,22 +1,24 @@
 package logic
 
 import (
-	"fmt"
-	"regexp"
-	"strings"
-
 	"github.com/TicketsBot-cloud/gdl/objects/member"
 	"github.com/TicketsBot-cloud/gdl/objects/user"
 	"github.com/TicketsBot-cloud/worker"
+	"github.com/TicketsBot-cloud/worker/bot/placeholders"
 )
 
 type SubstitutionFunc func(user user.User, member member.Member) string
 
+// SubstitutionConditionFunc decides whether an %if% block using the placeholder is rendered
+type SubstitutionConditionFunc func(user user.User, member member.Member) bool
+
 type Substitutor struct {
 	Placeholder string
 	NeedsUser   bool
 	NeedsMember bool
 	F           SubstitutionFunc
+	// Condition is used in %if% blocks instead of checking whether F returns an empty string
+	Condition SubstitutionConditionFunc
 }
 
 func NewSubstitutor(placeholder string, needsUser, needsMember bool, f SubstitutionFunc) Substitutor {
@@ -28,54 +30,10 @@ func NewSubstitutor(placeholder string, needsUser, needsMember bool, f Substitut
 	}
 }
 
-func DoSubstitutions(worker *worker.Context, s string, userId uint64, guildId uint64, substitutors []Substitutor) (string, error) {
-	var needsUser, needsMember bool
-
-	// Determine which objects we need to fetch
-	for _, substitutor := range substitutors {
-		if substitutor.NeedsUser {
-			needsUser = true
-		}
-
-		if substitutor.NeedsMember {
-			needsMember = true
-		}
-
-		if needsUser && needsMember {
-			break
-		}
-	}
-
-	// Retrieve user and member if necessary
-	var user user.User
-	var member member.Member
-
-	var err error
-	if needsUser {
-		user, err = worker.GetUser(userId)
-	}
-
-	if err != nil {
-		return "", err
-	}
-
-	if needsMember {
-		member, err = worker.GetGuildMember(guildId, userId)
-	}
-
-	if err != nil {
-		return "", err
-	}
-
-	for _, substitutor := range substitutors {
-		placeholder := fmt.Sprintf("%%%s%%", substitutor.Placeholder)
-
-		if strings.Contains(s, placeholder) {
-			s = strings.ReplaceAll(s, placeholder, substitutor.F(user, member))
-		}
-	}
-
-	return s, nil
+// WithCondition sets how the placeholder is evaluated in %if% blocks, for placeholders whose value is never empty
+func (s Substitutor) WithCondition(f SubstitutionConditionFunc) Substitutor {
+	s.Condition = f
+	return s
 }
 
 // ParameterizedSubstitutionFunc handles placeholders with optional parameters for naming schemes
@@ -98,10 +56,8 @@ func NewParameterizedSubstitutor(baseName string, needsUser, needsMember bool, f
 	}
 }
 
-// namingParamPlaceholderRegex matches %name% or %name:params%
-var namingParamPlaceholderRegex = regexp.MustCompile(`%([a-z_]+)(?::([^%]+))?%`)
-
-// DoSubstitutionsWithParams processes both simple and parameterized substitutions for naming schemes
+// DoSubstitutionsWithParams processes both simple and parameterized substitutions for naming schemes, along with
+// fallbacks, filters and %if% blocks
 func DoSubstitutionsWithParams(
 	worker *worker.Context,
 	s string,
@@ -110,69 +66,77 @@ func DoSubstitutionsWithParams(
 	substitutors []Substitutor,
 	paramSubstitutors []ParameterizedSubstitutor,
 ) (string, error) {
-	// First, handle parameterized substitutions
-	matches := namingParamPlaceholderRegex.FindAllStringSubmatch(s, -1)
-
-	// Determine if we need user/member for parameterized substitutors
-	var needsUserParam, needsMemberParam bool
-	for _, match := range matches {
-		baseName := match[1]
-		for _, ps := range paramSubstitutors {
-			if ps.BaseName == baseName {
-				if ps.NeedsUser {
-					needsUserParam = true
-				}
-				if ps.NeedsMember {
-					needsMemberParam = true
-				}
-				break
-			}
+	// Invalid tags are left as literal text, and are reported when the naming scheme is set on the dashboard
+	template, _ := placeholders.Parse(s)
+
+	resolver := substitutionResolver{
+		substitutors:      make(map[string]Substitutor),
+		paramSubstitutors: make(map[string]ParameterizedSubstitutor),
+	}
+
+	for _, substitutor := range substitutors {
+		resolver.substitutors[substitutor.Placeholder] = substitutor
+	}
+
+	for _, ps := range paramSubstitutors {
+		resolver.paramSubstitutors[ps.BaseName] = ps
+	}
+
+	// Determine which objects we need to fetch
+	var needsUser, needsMember bool
+	for _, name := range template.Names() {
+		if substitutor, ok := resolver.substitutors[name]; ok {
+			needsUser = needsUser || substitutor.NeedsUser
+			needsMember = needsMember || substitutor.NeedsMember
+		}
+
+		if ps, ok := resolver.paramSubstitutors[name]; ok {
+			needsUser = needsUser || ps.NeedsUser
+			needsMember = needsMember || ps.NeedsMember
 		}
 	}
 
-	// Get user/member if needed for parameterized substitutors
-	var userObj user.User
-	var memberObj member.Member
 	var err error
-
-	if needsUserParam {
-		userObj, err = worker.GetUser(userId)
+	if needsUser {
+		resolver.user, err = worker.GetUser(userId)
 		if err != nil {
 			return "", err
 		}
 	}
 
-	if needsMemberParam {
-		memberObj, err = worker.GetGuildMember(guildId, userId)
+	if needsMember {
+		resolver.member, err = worker.GetGuildMember(guildId, userId)
 		if err != nil {
 			return "", err
 		}
 	}
 
-	// Process parameterized substitutions
-	for _, match := range matches {
-		fullMatch := match[0]
-		baseName := match[1]
-		paramStr := ""
-		if len(match) > 2 {
-			paramStr = match[2]
-		}
+	return placeholders.Render(template, resolver), nil
+}
 
-		// Find matching parameterized substitutor
-		for _, ps := range paramSubstitutors {
-			if ps.BaseName == baseName {
-				var params []string
-				if paramStr != "" {
-					params = strings.Split(paramStr, ":")
-				}
-
-				replacement := ps.F(userObj, memberObj, params)
-				s = strings.Replace(s, fullMatch, replacement, 1)
-				break
-			}
-		}
+type substitutionResolver struct {
+	user              user.User
+	member            member.Member
+	substitutors      map[string]Substitutor
+	paramSubstitutors map[string]ParameterizedSubstitutor
+}
+
+func (r substitutionResolver) Resolve(name string, params []string) (string, bool) {
+	if substitutor, ok := r.substitutors[name]; ok && len(params) == 0 {
+		return substitutor.F(r.user, r.member), true
+	}
+
+	if ps, ok := r.paramSubstitutors[name]; ok {
+		return ps.F(r.user, r.member, params), true
+	}
+
+	return "", false
+}
+
+func (r substitutionResolver) Condition(name string, params []string) (bool, bool) {
+	if substitutor, ok := r.substitutors[name]; ok && len(params) == 0 && substitutor.Condition != nil {
+		return substitutor.Condition(r.user, r.member), true
 	}
 
-	// Then handle simple substitutions (existing behavior)
-	return DoSubstitutions(worker, s, userId, guildId, substitutors)
+	return false, false
 }
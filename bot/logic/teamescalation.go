@@ -0,0 +1,306 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/channel"
+	"github.com/TicketsBot-cloud/gdl/objects/channel/embed"
+	"github.com/TicketsBot-cloud/gdl/rest"
+	"github.com/TicketsBot-cloud/gdl/rest/request"
+	"github.com/TicketsBot-cloud/worker"
+	"github.com/TicketsBot-cloud/worker/bot/command"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+// EscalateTicketToTeam hands the ticket in the command's channel over to a support team: the ticket is unclaimed,
+// the team is given access to the channel and its on-call role is pinged
+func EscalateTicketToTeam(ctx context.Context, cmd registry.InteractionContext, teamId int, reason *string) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, cmd.ChannelId(), cmd.GuildId())
+	if err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	if ticket.Id == 0 || ticket.ChannelId == nil {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	hasPermission, err := HasPermissionForTicket(ctx, cmd.Worker(), ticket, cmd.UserId())
+	if err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	if !hasPermission {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageEscalateNoPermission)
+		return
+	}
+
+	if reason != nil && len(*reason) > 255 {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageEscalateReasonTooLong)
+		return
+	}
+
+	team, ok, err := dbclient.Client.SupportTeam.GetById(ctx, cmd.GuildId(), teamId)
+	if err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	if !ok {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageEscalateInvalidTeam)
+		return
+	}
+
+	escalation, err := dbclient.Storage.TicketEscalations.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	if escalation.Level > 0 && escalation.TeamId == team.Id {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageEscalateAlreadyEscalated, team.Name)
+		return
+	}
+
+	var panel *database.Panel
+	if ticket.PanelId != nil {
+		tmp, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
+		if err != nil {
+			cmd.HandleError(err)
+			return
+		}
+
+		if tmp.PanelId != 0 {
+			panel = &tmp
+		}
+	}
+
+	claimer, err := dbclient.Client.TicketClaims.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	ch, err := cmd.Worker().GetChannel(*ticket.ChannelId)
+	if err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	// Only rename the channel if it has not been manually renamed. The name must be generated before the escalation
+	// is stored, as it may contain the escalation level.
+	oldChannelName, err := GenerateChannelName(ctx, cmd.Worker(), panel, ticket.GuildId, ticket.Id, ticket.UserId, utils.NilIfZero(claimer))
+	if err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	shouldUpdateName := ch.Name == oldChannelName
+
+	// Channel renames are heavily ratelimited by Discord, so once the limit is reached the ticket is still escalated,
+	// just without being renamed
+	if shouldUpdateName {
+		shouldUpdateName, err = redis.TakeRenameRatelimit(ctx, *ticket.ChannelId)
+		if err != nil {
+			cmd.HandleError(err)
+			return
+		}
+	}
+
+	var reasonStr string
+	if reason != nil {
+		reasonStr = *reason
+	}
+
+	escalation.Level++
+	escalation.TeamId = team.Id
+	escalation.History = append(escalation.History, storage.TicketEscalationLog{
+		TeamId: team.Id,
+		UserId: cmd.UserId(),
+		Reason: reasonStr,
+		Time:   time.Now(),
+	})
+
+	auditReason := fmt.Sprintf("Escalated ticket %d to team '%s'", ticket.Id, team.Name)
+
+	// Give the team access before anything is stored, so that a failure leaves the ticket as it was. Thread
+	// permissions can't be changed, but the team's members are added to the thread when they are pinged below.
+	if !ticket.IsThread {
+		overwrites, err := escalatedTicketOverwrites(ctx, cmd, ticket, panel, ch.ParentId.Value, escalation)
+		if err != nil {
+			cmd.HandleError(err)
+			return
+		}
+
+		if _, err := cmd.Worker().ModifyChannel(request.WithAuditReason(ctx, auditReason), *ticket.ChannelId, rest.ModifyChannelData{
+			PermissionOverwrites: overwrites,
+		}); err != nil {
+			cmd.HandleError(err)
+			return
+		}
+	}
+
+	if claimer != 0 {
+		if err := dbclient.Client.TicketClaims.Delete(ctx, ticket.GuildId, ticket.Id); err != nil {
+			cmd.HandleError(err)
+			return
+		}
+	}
+
+	if err := dbclient.Storage.TicketEscalations.Set(ctx, ticket.GuildId, ticket.Id, escalation); err != nil {
+		cmd.HandleError(err)
+		return
+	}
+
+	// The new name may contain the escalation level, so can only be generated once the escalation is stored. The
+	// ticket has been escalated by this point, so failing to rename it is only a warning.
+	if shouldUpdateName {
+		if err := renameEscalatedTicket(ctx, cmd, ticket, panel, auditReason); err != nil {
+			cmd.HandleWarning(err)
+		}
+	}
+
+	if claimer != 0 {
+		if err := UpdateWelcomeMessageClaimButton(ctx, cmd.Worker(), cmd, ticket, false); err != nil {
+			cmd.HandleWarning(err)
+		}
+	}
+
+	// Ping the team's on-call role, or the whole team if it does not have one
+	var roles, users []uint64
+	if team.OnCallRole != nil {
+		roles = []uint64{*team.OnCallRole}
+	} else {
+		roles, users, err = escalationTargets(ctx, ticket, storage.EscalationPolicy{Target: storage.EscalationTargetTeam, TeamId: team.Id})
+		if err != nil {
+			cmd.HandleError(err)
+			return
+		}
+	}
+
+	content, allowedMentions := buildEscalationMentions(roles, users)
+
+	var msgEmbed *embed.Embed
+	if reason == nil {
+		msgEmbed = utils.BuildEmbed(cmd, customisation.Orange, i18n.TitleEscalation, i18n.MessageEscalateSuccess, nil, cmd.UserId(), team.Name, escalation.Level)
+	} else {
+		msgEmbed = utils.BuildEmbed(cmd, customisation.Orange, i18n.TitleEscalation, i18n.MessageEscalateSuccessWithReason, nil, cmd.UserId(), team.Name, escalation.Level, strings.ReplaceAll(*reason, "`", "\\`"))
+	}
+
+	if _, err := cmd.ReplyWith(command.MessageResponse{
+		Content:         content,
+		Embeds:          []*embed.Embed{msgEmbed},
+		AllowedMentions: allowedMentions,
+	}); err != nil {
+		cmd.HandleError(err)
+	}
+}
+
+// escalatedTicketOverwrites builds the ticket's overwrites as they will be once the given escalation is stored: the
+// ticket is unclaimed, so the usual overwrites apply, along with those for every team it has been escalated to
+func escalatedTicketOverwrites(ctx context.Context, cmd registry.InteractionContext, ticket database.Ticket, panel *database.Panel, categoryId uint64, escalation storage.TicketEscalation) ([]channel.PermissionOverwrite, error) {
+	members, err := dbclient.Client.TicketMembers.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	teamIds, err := TicketTeamIds(ctx, ticket, panel)
+	if err != nil {
+		return nil, err
+	}
+
+	overwrites, err := CreateOverwritesForTeams(ctx, cmd, ticket.UserId, panel, categoryId, teamIds, members...)
+	if err != nil {
+		return nil, err
+	}
+
+	teamOverwrites, err := BuildTeamOverwrites(ctx, cmd.Worker(), historyTeamIds(escalation.History)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(overwrites, teamOverwrites...), nil
+}
+
+func renameEscalatedTicket(ctx context.Context, cmd registry.InteractionContext, ticket database.Ticket, panel *database.Panel, auditReason string) error {
+	name, err := GenerateChannelName(ctx, cmd.Worker(), panel, ticket.GuildId, ticket.Id, ticket.UserId, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = cmd.Worker().ModifyChannel(request.WithAuditReason(ctx, auditReason), *ticket.ChannelId, rest.ModifyChannelData{
+		Name: name,
+	})
+	return err
+}
+
+// AppendEscalationOverwrites adds the overwrites for the teams the ticket has been escalated to, so that they keep
+// access when the ticket's permissions are rebuilt
+func AppendEscalationOverwrites(ctx context.Context, worker *worker.Context, ticket database.Ticket, overwrites []channel.PermissionOverwrite) ([]channel.PermissionOverwrite, error) {
+	teamIds, err := escalatedTeamIds(ctx, ticket)
+	if err != nil || len(teamIds) == 0 {
+		return overwrites, err
+	}
+
+	teamOverwrites, err := BuildTeamOverwrites(ctx, worker, teamIds...)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(overwrites, teamOverwrites...), nil
+}
+
+// TicketAccessTeamIds returns the support teams that can access the ticket: those returned by TicketTeamIds, along
+// with any it has been escalated to
+func TicketAccessTeamIds(ctx context.Context, ticket database.Ticket, panel *database.Panel) ([]int, error) {
+	teamIds, err := TicketTeamIds(ctx, ticket, panel)
+	if err != nil {
+		return nil, err
+	}
+
+	escalated, err := escalatedTeamIds(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, teamId := range escalated {
+		if !utils.Contains(teamIds, teamId) {
+			teamIds = append(teamIds, teamId)
+		}
+	}
+
+	return teamIds, nil
+}
+
+// escalatedTeamIds returns each team the ticket has been escalated to, in the order it was first escalated to them
+func escalatedTeamIds(ctx context.Context, ticket database.Ticket) ([]int, error) {
+	escalation, err := dbclient.Storage.TicketEscalations.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return historyTeamIds(escalation.History), nil
+}
+
+func historyTeamIds(history []storage.TicketEscalationLog) []int {
+	var teamIds []int
+	for _, entry := range history {
+		if !utils.Contains(teamIds, entry.TeamId) {
+			teamIds = append(teamIds, entry.TeamId)
+		}
+	}
+
+	return teamIds
+}
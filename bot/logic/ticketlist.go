@@ -0,0 +1,245 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/database"
+	"github.com/TicketsBot-cloud/gdl/objects/interaction/component"
+	"github.com/TicketsBot-cloud/worker/bot/command/registry"
+	"github.com/TicketsBot-cloud/worker/bot/customisation"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+	"github.com/TicketsBot-cloud/worker/i18n"
+)
+
+const ticketListPerPage = 10
+const ticketListCustomIdPrefix = "tickets_list_"
+
+type TicketListFilter struct {
+	Mine      bool
+	Unclaimed bool
+	PanelId   int
+	UserId    uint64
+	LabelId   int
+}
+
+// CustomId encodes the filter and page into a button custom ID, so that pagination does not need any state
+func (f TicketListFilter) CustomId(page int) string {
+	return fmt.Sprintf("%s%d_%s_%s_%d_%d_%d", ticketListCustomIdPrefix, page, boolToFlag(f.Mine), boolToFlag(f.Unclaimed), f.PanelId, f.UserId, f.LabelId)
+}
+
+func IsTicketListCustomId(customId string) bool {
+	return strings.HasPrefix(customId, ticketListCustomIdPrefix)
+}
+
+func ParseTicketListCustomId(customId string) (TicketListFilter, int, bool) {
+	parts := strings.Split(strings.TrimPrefix(customId, ticketListCustomIdPrefix), "_")
+	if len(parts) != 6 {
+		return TicketListFilter{}, 0, false
+	}
+
+	page, err := strconv.Atoi(parts[0])
+	if err != nil || page < 0 {
+		return TicketListFilter{}, 0, false
+	}
+
+	panelId, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return TicketListFilter{}, 0, false
+	}
+
+	userId, err := strconv.ParseUint(parts[4], 10, 64)
+	if err != nil {
+		return TicketListFilter{}, 0, false
+	}
+
+	labelId, err := strconv.Atoi(parts[5])
+	if err != nil {
+		return TicketListFilter{}, 0, false
+	}
+
+	filter := TicketListFilter{
+		Mine:      parts[1] == "1",
+		Unclaimed: parts[2] == "1",
+		PanelId:   panelId,
+		UserId:    userId,
+		LabelId:   labelId,
+	}
+
+	return filter, page, true
+}
+
+func BuildTicketListButtons(filter TicketListFilter, page, totalPages int) component.Component {
+	return component.BuildActionRow(
+		component.BuildButton(component.Button{
+			CustomId: filter.CustomId(page - 1),
+			Style:    component.ButtonStyleDanger,
+			Label:    "<",
+			Disabled: page <= 0,
+		}),
+		component.BuildButton(component.Button{
+			CustomId: "tickets_list_page_count",
+			Style:    component.ButtonStyleSecondary,
+			Label:    fmt.Sprintf("%d/%d", page+1, totalPages),
+			Disabled: true,
+		}),
+		component.BuildButton(component.Button{
+			CustomId: filter.CustomId(page + 1),
+			Style:    component.ButtonStyleSuccess,
+			Label:    ">",
+			Disabled: page >= totalPages-1,
+		}),
+	)
+}
+
+// BuildTicketListMessage lists the guild's open tickets that match the filter, most recently active first
+func BuildTicketListMessage(ctx context.Context, cmd registry.CommandContext, filter TicketListFilter, page int) (component.Component, int, int, error) {
+	tickets, err := dbclient.Client.Tickets.GetGuildOpenTicketsWithMetadata(ctx, cmd.GuildId())
+	if err != nil {
+		return component.Component{}, 0, 0, err
+	}
+
+	var labelAssignments map[int][]int
+	if filter.LabelId != 0 && len(tickets) > 0 {
+		ticketIds := make([]int, len(tickets))
+		for i, ticket := range tickets {
+			ticketIds[i] = ticket.Id
+		}
+
+		labelAssignments, err = dbclient.Client.TicketLabelAssignments.GetByTickets(ctx, cmd.GuildId(), ticketIds)
+		if err != nil {
+			return component.Component{}, 0, 0, err
+		}
+	}
+
+	filtered := make([]database.TicketWithMetadata, 0, len(tickets))
+	for _, ticket := range tickets {
+		if filter.matches(cmd.UserId(), ticket, labelAssignments) {
+			filtered = append(filtered, ticket)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return lastActivity(filtered[i]).After(lastActivity(filtered[j]))
+	})
+
+	panels, err := dbclient.Client.Panel.GetByGuild(ctx, cmd.GuildId())
+	if err != nil {
+		return component.Component{}, 0, 0, err
+	}
+
+	panelTitles := make(map[int]string, len(panels))
+	for _, panel := range panels {
+		panelTitles[panel.PanelId] = panel.Title
+	}
+
+	totalPages := (len(filtered) + ticketListPerPage - 1) / ticketListPerPage
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	if page < 0 {
+		page = 0
+	}
+	if page >= totalPages {
+		page = totalPages - 1
+	}
+
+	var content strings.Builder
+	if len(filtered) == 0 {
+		content.WriteString(cmd.GetMessage(i18n.MessageTicketsListEmpty))
+	} else {
+		content.WriteString(cmd.GetMessage(i18n.MessageTicketsListCount, len(filtered)))
+
+		lower := page * ticketListPerPage
+		upper := min(lower+ticketListPerPage, len(filtered))
+		for _, ticket := range filtered[lower:upper] {
+			content.WriteString("\n\n")
+			content.WriteString(formatTicketListEntry(cmd, ticket, panelTitles))
+		}
+	}
+
+	container := utils.BuildContainerWithComponents(cmd, customisation.Green, i18n.MessageTicketsListTitle, utils.Slice(
+		component.BuildTextDisplay(component.TextDisplay{Content: content.String()}),
+	))
+
+	return container, page, totalPages, nil
+}
+
+func (f TicketListFilter) matches(viewerId uint64, ticket database.TicketWithMetadata, labelAssignments map[int][]int) bool {
+	if f.Mine && (ticket.ClaimedBy == nil || *ticket.ClaimedBy != viewerId) {
+		return false
+	}
+
+	if f.Unclaimed && ticket.ClaimedBy != nil {
+		return false
+	}
+
+	if f.PanelId != 0 && (ticket.PanelId == nil || *ticket.PanelId != f.PanelId) {
+		return false
+	}
+
+	if f.UserId != 0 && ticket.Ticket.UserId != f.UserId {
+		return false
+	}
+
+	if f.LabelId != 0 && !utils.Contains(labelAssignments[ticket.Id], f.LabelId) {
+		return false
+	}
+
+	return true
+}
+
+func formatTicketListEntry(cmd registry.CommandContext, ticket database.TicketWithMetadata, panelTitles map[int]string) string {
+	channel := fmt.Sprintf("#%d", ticket.Id)
+	if ticket.ChannelId != nil {
+		channel = fmt.Sprintf("<#%d>", *ticket.ChannelId)
+	}
+
+	var claimer string
+	if ticket.ClaimedBy != nil {
+		claimer = cmd.GetMessage(i18n.MessageTicketsListClaimedBy, *ticket.ClaimedBy)
+	} else {
+		claimer = cmd.GetMessage(i18n.MessageTicketsListUnclaimed)
+	}
+
+	panel := cmd.GetMessage(i18n.MessageTicketsListNoPanel)
+	if ticket.PanelId != nil {
+		if title, ok := panelTitles[*ticket.PanelId]; ok {
+			panel = title
+		}
+	}
+
+	return cmd.GetMessage(
+		i18n.MessageTicketsListEntry,
+		ticket.Id,
+		channel,
+		ticket.Ticket.UserId,
+		claimer,
+		panel,
+		strings.ToLower(string(ticket.Status)),
+		ticket.OpenTime.Unix(),
+		lastActivity(ticket).Unix(),
+	)
+}
+
+func lastActivity(ticket database.TicketWithMetadata) time.Time {
+	if ticket.LastMessageTime != nil {
+		return *ticket.LastMessageTime
+	}
+
+	return ticket.OpenTime
+}
+
+func boolToFlag(b bool) string {
+	if b {
+		return "1"
+	}
+
+	return "0"
+}
@@ -41,7 +41,7 @@ func BuildViewStaffButtons(page, totalPages int) component.Component {
 	)
 }
 
-func buildPaginatedField(cmd registry.CommandContext, entries []uint64, page int, labelId i18n.MessageId, emptyId *i18n.MessageId, format string, prefix *i18n.MessageId) (string, string) {
+func buildPaginatedField(cmd registry.CommandContext, entries []uint64, page int, labelId i18n.MessageId, emptyId *i18n.MessageId, format string, prefix *i18n.MessageId, annotate func(uint64) string) (string, string) {
 	lower := perField * page
 	upper := perField * (page + 1)
 	if upper > len(entries) {
@@ -61,7 +61,12 @@ func buildPaginatedField(cmd registry.CommandContext, entries []uint64, page int
 		content.WriteString("\n")
 	}
 	for i := lower; i < upper; i++ {
-		content.WriteString(fmt.Sprintf(format, entries[i], entries[i]))
+		line := fmt.Sprintf(format, entries[i], entries[i])
+		if annotate != nil {
+			line = fmt.Sprintf("%s %s\n", strings.TrimSuffix(line, "\n"), annotate(entries[i]))
+		}
+
+		content.WriteString(line)
 	}
 	return label, strings.TrimSuffix(content.String(), "\n")
 }
@@ -89,6 +94,30 @@ func BuildViewStaffMessage(ctx context.Context, cmd registry.CommandContext, pag
 		sentry.ErrorWithContext(err, cmd.ToErrorContext())
 	}
 
+	openClaims, err := GetOpenClaimCounts(ctx, cmd.GuildId())
+	if err != nil {
+		sentry.ErrorWithContext(err, cmd.ToErrorContext())
+	}
+
+	claimLimits, err := LoadClaimLimits(ctx, cmd.GuildId())
+	if err != nil {
+		sentry.ErrorWithContext(err, cmd.ToErrorContext())
+	}
+
+	claimLoad := func(userId uint64) string {
+		// Team limits can apply by role, but a user who has left the server still has their direct limit shown
+		var roles []uint64
+		if member, err := cmd.Worker().GetGuildMember(cmd.GuildId(), userId); err == nil {
+			roles = member.Roles
+		}
+
+		if limit := claimLimits.For(userId, roles); limit > 0 {
+			return cmd.GetMessage(i18n.MessageViewStaffClaimLoadLimited, openClaims[userId], limit)
+		}
+
+		return cmd.GetMessage(i18n.MessageViewStaffClaimLoad, openClaims[userId])
+	}
+
 	maxLen := max(len(adminUsers), len(adminRoles), len(supportUsers), len(supportRoles))
 	totalPages := (maxLen + perField - 1) / perField
 	if totalPages == 0 {
@@ -109,6 +138,7 @@ func BuildViewStaffMessage(ctx context.Context, cmd registry.CommandContext, pag
 		&i18n.MessageViewStaffNoAdminRoles,
 		viewStaffRoleFormat,
 		nil,
+		nil,
 	)
 	innerComponents = append(innerComponents, component.BuildTextDisplay(component.TextDisplay{Content: fmt.Sprintf("**%s**\n%s", label, value)}))
 
@@ -120,6 +150,7 @@ func BuildViewStaffMessage(ctx context.Context, cmd registry.CommandContext, pag
 		&i18n.MessageViewStaffNoAdminUsers,
 		viewStaffUserFormat,
 		nil,
+		claimLoad,
 	)
 	innerComponents = append(innerComponents, component.BuildTextDisplay(component.TextDisplay{Content: fmt.Sprintf("**%s**\n%s", label, value)}))
 
@@ -131,6 +162,7 @@ func BuildViewStaffMessage(ctx context.Context, cmd registry.CommandContext, pag
 		&i18n.MessageViewStaffNoSupportRoles,
 		viewStaffRoleFormat,
 		nil,
+		nil,
 	)
 	innerComponents = append(innerComponents, component.BuildTextDisplay(component.TextDisplay{Content: fmt.Sprintf("**%s**\n%s", label, value)}))
 
@@ -143,6 +175,7 @@ func BuildViewStaffMessage(ctx context.Context, cmd registry.CommandContext, pag
 			nil,
 			viewStaffUserFormat,
 			&i18n.MessageViewStaffSupportUsersWarn,
+			claimLoad,
 		)
 		innerComponents = append(innerComponents, component.BuildTextDisplay(component.TextDisplay{Content: fmt.Sprintf("**%s**\n%s", label, value)}))
 	}
@@ -3,12 +3,10 @@ package logic
 import (
 	"context"
 	"fmt"
-	"regexp"
-	"sort"
 	"strconv"
-	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/TicketsBot-cloud/common/premium"
 	"github.com/TicketsBot-cloud/common/sentry"
@@ -22,6 +20,8 @@ import (
 	"github.com/TicketsBot-cloud/worker/bot/customisation"
 	"github.com/TicketsBot-cloud/worker/bot/dbclient"
 	"github.com/TicketsBot-cloud/worker/bot/integrations"
+	"github.com/TicketsBot-cloud/worker/bot/placeholders"
+	"github.com/TicketsBot-cloud/worker/bot/storage"
 	"github.com/TicketsBot-cloud/worker/bot/utils"
 	"github.com/TicketsBot-cloud/worker/config"
 	"github.com/TicketsBot-cloud/worker/i18n"
@@ -35,7 +35,7 @@ func SendWelcomeMessage(
 	ticket database.Ticket,
 	subject string,
 	panel *database.Panel,
-	formData map[database.FormInput]string,
+	formAnswers storage.TicketFormAnswers,
 	// Only custom integration placeholders for now - prevent making duplicate requests
 	additionalPlaceholders map[string]string,
 ) (uint64, error) {
@@ -50,24 +50,9 @@ func SendWelcomeMessage(
 		return 0, err
 	}
 
-	embeds := utils.Slice(welcomeMessageEmbed)
-
-	// Put form fields in a separate embed
-	fields := getFormDataFields(formData)
-	if len(fields) > 0 {
-		formAnswersEmbed := embed.NewEmbed().
-			SetColor(welcomeMessageEmbed.Color)
-
-		for _, field := range fields {
-			formAnswersEmbed.AddField(field.Name, utils.EscapeMarkdown(field.Value), field.Inline)
-		}
-
-		if cmd.PremiumTier() == premium.None {
-			formAnswersEmbed.SetFooter(fmt.Sprintf("Powered by %s", config.Conf.Bot.PoweredBy), config.Conf.Bot.IconUrl)
-		}
-
-		embeds = append(embeds, formAnswersEmbed)
-	}
+	// Put form fields in separate embeds. If they don't all fit in the welcome message, the rest are sent after it.
+	embeds := append(utils.Slice(welcomeMessageEmbed), BuildFormAnswerEmbeds(formAnswers, welcomeMessageEmbed.Color, cmd.PremiumTier() == premium.None)...)
+	batches := utils.BatchEmbeds(embeds)
 
 	hideClose := settings.HideCloseButton
 	hideCloseWithReason := settings.HideCloseWithReasonButton
@@ -105,8 +90,22 @@ func SendWelcomeMessage(
 		}))
 	}
 
+	snoozeSettings, err := dbclient.Storage.SnoozeSettings.Get(ctx, ticket.GuildId)
+	if err != nil {
+		return 0, err
+	}
+
+	if snoozeSettings.ShowButton {
+		buttons = append(buttons, component.BuildButton(component.Button{
+			Label:    cmd.GetMessage(i18n.TitleSnooze),
+			CustomId: "snooze",
+			Style:    component.ButtonStyleSecondary,
+			Emoji:    &emoji.Emoji{Name: "💤"},
+		}))
+	}
+
 	data := rest.CreateMessageData{
-		Embeds: embeds,
+		Embeds: batches[0],
 	}
 
 	if len(buttons) > 0 {
@@ -125,6 +124,14 @@ func SendWelcomeMessage(
 		return 0, err
 	}
 
+	// The ticket has still been welcomed if the remaining answers can't be sent
+	for _, batch := range batches[1:] {
+		if _, err := cmd.Worker().CreateMessageComplex(*ticket.ChannelId, rest.CreateMessageData{Embeds: batch}); err != nil {
+			sentry.ErrorWithContext(err, cmd.ToErrorContext())
+			break
+		}
+	}
+
 	return msg.Id, nil
 }
 
@@ -167,6 +174,8 @@ func BuildWelcomeMessageEmbed(
 	}
 }
 
+// DoPlaceholderSubstitutions replaces the placeholders in a welcome message or tag, along with fallbacks, filters and
+// %if% blocks
 func DoPlaceholderSubstitutions(
 	ctx context.Context,
 	message string,
@@ -175,71 +184,97 @@ func DoPlaceholderSubstitutions(
 	// Only custom integration placeholders for now - prevent making duplicate requests
 	additionalPlaceholders map[string]string,
 ) string {
-	// Handle escaped placeholders first: \%...\% -> temporary marker
-	escapedPlaceholderRegex := regexp.MustCompile(`\\%([a-z_]+(?::[^%\\]+)?)\\%`)
-	escapedPlaceholders := make(map[string]string)
-	counter := 0
-	message = escapedPlaceholderRegex.ReplaceAllStringFunc(message, func(match string) string {
-		marker := fmt.Sprintf("\x00ESCAPED_%d\x00", counter)
-		// Extract the content between \% and \%
-		content := escapedPlaceholderRegex.FindStringSubmatch(match)[1]
-		escapedPlaceholders[marker] = fmt.Sprintf("%%%s%%", content)
-		counter++
-		return marker
-	})
-
-	// Process parameterized placeholders first (e.g., %date_days:30%)
-	message = doParameterizedSubstitutions(ctx, message, worker, ticket)
+	// Custom integration placeholder names are matched exactly, as they need not be valid placeholder names
+	integrationNames := make([]string, 0, len(additionalPlaceholders))
+	for name := range additionalPlaceholders {
+		integrationNames = append(integrationNames, name)
+	}
+
+	// Invalid tags are left as literal text, and are reported when the message is set on the dashboard
+	template, _ := placeholders.ParseWithNames(message, integrationNames)
+
+	resolver := placeholderResolver{
+		ctx:                    ctx,
+		worker:                 worker,
+		ticket:                 ticket,
+		values:                 make(map[string]string),
+		additionalPlaceholders: additionalPlaceholders,
+	}
 
 	var lock sync.Mutex
 
 	// do DB lookups in parallel
 	group, _ := errgroup.WithContext(ctx)
-	for placeholder, f := range substitutions {
-		placeholder := placeholder
-		f := f
+	for _, name := range template.Names() {
+		name := name
 
-		formatted := fmt.Sprintf("%%%s%%", placeholder)
+		f, ok := substitutions[name]
+		if !ok {
+			continue
+		}
 
-		if strings.Contains(message, formatted) {
-			group.Go(func() error {
-				ctx, cancel := context.WithTimeout(ctx, substitutionTimeout)
-				defer cancel()
+		group.Go(func() error {
+			ctx, cancel := context.WithTimeout(ctx, substitutionTimeout)
+			defer cancel()
 
-				replacement := f(ctx, worker, ticket)
+			replacement := f(ctx, worker, ticket)
 
-				lock.Lock()
-				message = strings.Replace(message, formatted, replacement, -1)
-				lock.Unlock()
+			lock.Lock()
+			resolver.values[name] = replacement
+			lock.Unlock()
 
-				return nil
-			})
-		}
-	}
-
-	for placeholder, replacement := range additionalPlaceholders {
-		formatted := fmt.Sprintf("%%%s%%", placeholder)
-		lock.Lock()
-		message = strings.Replace(message, formatted, replacement, -1)
-		lock.Unlock()
+			return nil
+		})
 	}
 
 	if err := group.Wait(); err != nil {
 		sentry.Error(err)
 	}
 
-	// Restore escaped placeholders
-	for marker, literal := range escapedPlaceholders {
-		message = strings.Replace(message, marker, literal, -1)
+	return placeholders.Render(template, resolver)
+}
+
+type placeholderResolver struct {
+	ctx                    context.Context
+	worker                 *worker.Context
+	ticket                 database.Ticket
+	values                 map[string]string
+	additionalPlaceholders map[string]string
+}
+
+func (r placeholderResolver) Resolve(name string, params []string) (string, bool) {
+	if len(params) > 0 {
+		if handler, ok := parameterizedSubstitutions[name]; ok {
+			return handler(r.ctx, r.worker, r.ticket, params), true
+		}
+
+		return "", false
+	}
+
+	if value, ok := r.values[name]; ok {
+		return value, true
+	}
+
+	value, ok := r.additionalPlaceholders[name]
+	return value, ok
+}
+
+func (r placeholderResolver) Condition(name string, params []string) (bool, bool) {
+	f, ok := placeholderConditions[name]
+	if !ok || len(params) > 0 {
+		return false, false
 	}
 
-	return message
+	ctx, cancel := context.WithTimeout(r.ctx, substitutionTimeout)
+	defer cancel()
+
+	return f(ctx, r.worker, r.ticket), true
 }
 
 func fetchCustomIntegrationPlaceholders(
 	ctx context.Context,
 	ticket database.Ticket,
-	formAnswers map[string]*string,
+	formAnswers storage.TicketFormAnswers,
 ) (map[string]string, error) {
 	// Custom integrations
 	guildIntegrations, err := dbclient.Client.CustomIntegrationGuilds.GetGuildIntegrations(ctx, ticket.GuildId)
@@ -323,10 +358,11 @@ type PlaceholderSubstitutionFunc func(context.Context, *worker.Context, database
 // params will be empty slice for non-parameterized usage
 type ParameterizedPlaceholderFunc func(ctx context.Context, worker *worker.Context, ticket database.Ticket, params []string) string
 
-const substitutionTimeout = time.Millisecond * 1500
+// PlaceholderConditionFunc decides whether an %if% block using the placeholder is rendered, for placeholders that are
+// not simply checked for an empty value
+type PlaceholderConditionFunc func(context.Context, *worker.Context, database.Ticket) bool
 
-// Regex to match parameterized placeholders: %name% or %name:param% or %name:param1:param2%
-var parameterizedPlaceholderRegex = regexp.MustCompile(`%([a-z_]+):([^%]+)%`)
+const substitutionTimeout = time.Millisecond * 1500
 
 // parameterizedSubstitutions maps placeholder base names to parameterized functions
 var parameterizedSubstitutions = map[string]ParameterizedPlaceholderFunc{
@@ -409,44 +445,9 @@ var parameterizedSubstitutions = map[string]ParameterizedPlaceholderFunc{
 		targetTime := time.Now().AddDate(0, 0, days)
 		return strconv.FormatInt(targetTime.Unix(), 10)
 	},
-}
-
-// doParameterizedSubstitutions processes parameterized placeholders in the message
-func doParameterizedSubstitutions(
-	ctx context.Context,
-	message string,
-	worker *worker.Context,
-	ticket database.Ticket,
-) string {
-	// Find all parameterized placeholder matches
-	matches := parameterizedPlaceholderRegex.FindAllStringSubmatchIndex(message, -1)
-	if len(matches) == 0 {
-		return message
-	}
-
-	// Process matches in reverse order to preserve indices
-	for i := len(matches) - 1; i >= 0; i-- {
-		match := matches[i]
-		placeholderName := message[match[2]:match[3]]
-
-		// Check if this is a parameterized placeholder we handle
-		handler, exists := parameterizedSubstitutions[placeholderName]
-		if !exists {
-			continue
-		}
-
-		// Extract parameters
-		paramString := message[match[4]:match[5]]
-		params := strings.Split(paramString, ":")
-
-		// Call the handler
-		replacement := handler(ctx, worker, ticket, params)
-
-		// Replace in message
-		message = message[:match[0]] + replacement + message[match[1]:]
-	}
 
-	return message
+	// %form:LABEL% or %form:CUSTOM_ID% - The answer given to the form input when the ticket was opened
+	"form": formAnswerPlaceholder,
 }
 
 var substitutions = map[string]PlaceholderSubstitutionFunc{
@@ -583,6 +584,10 @@ var substitutions = map[string]PlaceholderSubstitutionFunc{
 
 		return utils.FormatNullableTime(data.AllTime)
 	},
+	"priority": func(ctx context.Context, worker *worker.Context, ticket database.Ticket) string {
+		priority, _ := dbclient.Storage.TicketPriorities.Get(ctx, ticket.GuildId, ticket.Id)
+		return string(priority)
+	},
 	"discord_account_creation_date": func(ctx context.Context, worker *worker.Context, ticket database.Ticket) string {
 		return fmt.Sprintf("<t:%d:d>", utils.SnowflakeToTime(ticket.UserId).Unix())
 	},
@@ -591,62 +596,53 @@ var substitutions = map[string]PlaceholderSubstitutionFunc{
 	},
 }
 
-func formAnswersToMap(formData map[database.FormInput]string) map[string]*string {
-	// Get form inputs in the same order they are presented on the dashboard
-	i := 0
-	inputs := make([]database.FormInput, len(formData))
-	for input := range formData {
-		inputs[i] = input
-		i++
-	}
+var placeholderConditions = map[string]PlaceholderConditionFunc{
+	// %if claimed%
+	"claimed": func(ctx context.Context, _ *worker.Context, ticket database.Ticket) bool {
+		claimer, _ := dbclient.Client.TicketClaims.Get(ctx, ticket.GuildId, ticket.Id)
+		return claimer != 0
+	},
+}
 
-	sort.Slice(inputs, func(i, j int) bool {
-		return inputs[i].Position < inputs[j].Position
-	})
+// BuildFormAnswerEmbeds lists the answers given when a ticket was opened, split over as many embeds as are needed to
+// hold them. Long answers are truncated to fit in a field, and each embed is kept within Discord's size limits, but
+// the embeds may still need to be split over several messages with utils.BatchEmbeds.
+func BuildFormAnswerEmbeds(formAnswers storage.TicketFormAnswers, colour int, branding bool) []*embed.Embed {
+	footer := fmt.Sprintf("Powered by %s", config.Conf.Bot.PoweredBy)
 
-	answers := make(map[string]*string)
-	for _, input := range inputs {
-		answer, ok := formData[input]
-		if ok {
-			answers[input.Label] = &answer
-		} else {
-			answers[input.Label] = nil
-		}
+	// The footer is only added to the last embed, but room is left for it in each
+	maxLength := utils.MaxEmbedCharacters
+	if branding {
+		maxLength -= utf8.RuneCountInString(footer)
 	}
 
-	return answers
-}
-
-func getFormDataFields(formData map[database.FormInput]string) []embed.EmbedField {
-	// Get form inputs in the same order they are presented on the dashboard
-	i := 0
-	inputs := make([]database.FormInput, len(formData))
-	for input := range formData {
-		inputs[i] = input
-		i++
-	}
+	var embeds []*embed.Embed
+	var length int
+	for _, answer := range formAnswers {
+		value := answer.Answer
+		if value == "" {
+			value = "N/A" // TODO: What should we use here?
+		}
 
-	sort.Slice(inputs, func(i, j int) bool {
-		return inputs[i].Position < inputs[j].Position
-	})
+		name := utils.TruncateCharacters(answer.Label, utils.MaxEmbedFieldName)
+		value = utils.TruncateCharacters(utils.EscapeMarkdown(value), utils.MaxEmbedFieldValue)
+		fieldLength := utf8.RuneCountInString(name) + utf8.RuneCountInString(value)
 
-	var fields []embed.EmbedField // Can't use len(formData), as form may have changed since modal was opened
-	for _, input := range inputs {
-		answer, ok := formData[input]
-		if answer == "" {
-			answer = "N/A" // TODO: What should we use here?
+		if len(embeds) == 0 || len(embeds[len(embeds)-1].Fields) == utils.MaxEmbedFields || length+fieldLength > maxLength {
+			embeds = append(embeds, embed.NewEmbed().
+				SetColor(colour))
+			length = 0
 		}
 
-		if ok {
-			fields = append(fields, embed.EmbedField{
-				Name:   input.Label,
-				Value:  answer,
-				Inline: false,
-			})
-		}
+		embeds[len(embeds)-1].AddField(name, value, false)
+		length += fieldLength
+	}
+
+	if branding && len(embeds) > 0 {
+		embeds[len(embeds)-1].SetFooter(footer, config.Conf.Bot.IconUrl)
 	}
 
-	return fields
+	return embeds
 }
 
 func BuildCustomEmbed(
@@ -0,0 +1,41 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/TicketsBot-cloud/gdl/cache"
+	"github.com/TicketsBot-cloud/worker"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/config"
+)
+
+// BuildWorkerContext builds a context for work that does not originate from an event or interaction, using the
+// guild's whitelabel bot if it has one
+func BuildWorkerContext(ctx context.Context, guildId uint64, cache *cache.PgCache) (*worker.Context, error) {
+	worker := &worker.Context{
+		Cache:       cache,
+		RateLimiter: nil, // Use http-proxy ratelimiting functionality
+	}
+
+	whitelabelBotId, isWhitelabel, err := dbclient.Client.WhitelabelGuilds.GetBotByGuild(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	worker.IsWhitelabel = isWhitelabel
+
+	if isWhitelabel {
+		res, err := dbclient.Client.Whitelabel.GetByBotId(ctx, whitelabelBotId)
+		if err != nil {
+			return nil, err
+		}
+
+		worker.Token = res.Token
+		worker.BotId = whitelabelBotId
+	} else {
+		worker.Token = config.Conf.Discord.Token
+		worker.BotId = config.Conf.Discord.PublicBotId
+	}
+
+	return worker, err
+}
@@ -29,6 +29,7 @@ var (
 	DiscordApiErrors    = newCounterVec("discord_api_errors", "status", "error_code")
 
 	InboundRequests           = newCounterVec("inbound_requests", "route")
+	RejectedInboundRequests   = newCounterVec("rejected_inbound_requests", "route", "reason")
 	ActiveInteractions        = newGauge("active_interactions")
 	InteractionTimeToComplete = newHistogram("interaction_time_to_complete")
 
@@ -38,6 +39,16 @@ var (
 	KafkaBatchSize = newHistogram("kafka_batch_size")
 	KafkaMessages  = newHistogramVec("kafka_messages", "topic")
 
+	DeadLetteredEvents = newCounterVec("dead_lettered_events", "event_type")
+	RedeliveredEvents  = newCounterVec("redelivered_events", "event_type")
+
+	EventPartitionQueueDepth = newGaugeVec("event_partition_queue_depth", "partition")
+
+	FiredTimers = newCounterVec("fired_timers", "kind", "result")
+
+	HttpEventQueueDepth = newGauge("http_event_queue_depth")
+	DroppedHttpEvents   = newCounterVec("dropped_http_events", "reason")
+
 	CategoryUpdates = newCounter("category_updates")
 )
 
@@ -81,6 +92,14 @@ func newGauge(name string) prometheus.Gauge {
 	})
 }
 
+func newGaugeVec(name string, labels ...string) *prometheus.GaugeVec {
+	return promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: Subsystem,
+		Name:      name,
+	}, labels)
+}
+
 func LogIntegrationRequest(integration database.CustomIntegration, guildId uint64) {
 	IntegrationRequests.WithLabelValues(
 		strconv.Itoa(integration.Id),
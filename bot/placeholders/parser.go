@@ -0,0 +1,441 @@
+// Package placeholders parses and renders the placeholder language used in welcome messages, tags and naming
+// schemes:
+//
+//	%name%                    the value of a placeholder
+//	%name:param1:param2%      a placeholder with parameters, e.g. %date_days:7%
+//	%nickname|username%       fallbacks: the first placeholder with a non-empty value is used
+//	%nickname|"friend"%       a quoted literal can be used as the last fallback
+//	%username|upper%          filters: upper, lower and truncate:N, applied in order after the fallbacks
+//	%if claimed%...%endif%    only rendered if the condition is true, with an optional %else%
+//	%if !claimed%...%endif%   negated condition
+//	\%name\%                  escaped, rendered as the literal text %name%
+//
+// A percent sign that does not start a placeholder, such as in "50% off", is kept as literal text. Placeholders with
+// unknown names are rendered unchanged.
+package placeholders
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type (
+	Template struct {
+		Nodes []Node
+	}
+
+	Node interface {
+		node()
+	}
+
+	// Text is literal text
+	Text string
+
+	// Placeholder is replaced with the value of the first of its alternatives that is non-empty, after the filters
+	// have been applied
+	Placeholder struct {
+		// Raw is the placeholder as it was written, including the percent signs
+		Raw          string
+		Alternatives []Value
+		Filters      []Filter
+	}
+
+	// Value is either a named placeholder with its parameters, or a quoted literal
+	Value struct {
+		Name    string
+		Params  []string
+		Literal *string
+	}
+
+	Filter struct {
+		Name   string
+		Params []string
+	}
+
+	// Conditional renders Then if the condition is true, otherwise Else
+	Conditional struct {
+		Condition Value
+		Negated   bool
+		Then      []Node
+		Else      []Node
+	}
+)
+
+func (Text) node()         {}
+func (*Placeholder) node() {}
+func (*Conditional) node() {}
+
+// SyntaxError describes a tag that could not be parsed. Line and Column are 1-indexed, with the column counted in
+// characters rather than bytes.
+type SyntaxError struct {
+	Offset  int
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+// SyntaxErrors is returned by Parse if the template contains any invalid tags
+type SyntaxErrors []*SyntaxError
+
+func (e SyntaxErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Parse parses a template. If any tags are invalid, a SyntaxErrors listing each of them is returned along with a
+// template in which the invalid tags are treated as literal text and unclosed %if% blocks are closed at the end, so
+// that the template can still be rendered.
+func Parse(s string) (Template, error) {
+	return ParseWithNames(s, nil)
+}
+
+// ParseWithNames parses a template in which each of names, such as the names of custom integration placeholders, is
+// also a placeholder. These are matched exactly as written, as they need not follow the rules for placeholder names,
+// and so can't be given parameters, fallbacks or filters.
+func ParseWithNames(s string, names []string) (Template, error) {
+	p := parser{input: s, names: make(map[string]bool, len(names))}
+	for _, name := range names {
+		p.names[name] = true
+	}
+
+	p.parse()
+
+	if len(p.errors) > 0 {
+		return p.template, p.errors
+	}
+
+	return p.template, nil
+}
+
+type (
+	parser struct {
+		input    string
+		names    map[string]bool
+		template Template
+		text     strings.Builder
+		stack    []*openConditional
+		errors   SyntaxErrors
+	}
+
+	openConditional struct {
+		conditional *Conditional
+		raw         string
+		offset      int
+		inElse      bool
+	}
+)
+
+func (p *parser) parse() {
+	for i := 0; i < len(p.input); {
+		// \% is an escaped percent sign
+		if strings.HasPrefix(p.input[i:], `\%`) {
+			p.text.WriteByte('%')
+			i += 2
+			continue
+		}
+
+		if p.input[i] != '%' {
+			p.text.WriteByte(p.input[i])
+			i++
+			continue
+		}
+
+		end := strings.IndexByte(p.input[i+1:], '%')
+		if end == -1 {
+			p.text.WriteString(p.input[i:])
+			break
+		}
+
+		raw := p.input[i : i+end+2]
+		if p.parseTag(raw, i) {
+			i += len(raw)
+		} else {
+			// Not a tag, so the percent sign is literal text, and the closing percent sign may start another tag
+			p.text.WriteByte('%')
+			i++
+		}
+	}
+
+	p.flushText()
+
+	for i := len(p.stack) - 1; i >= 0; i-- {
+		p.error(p.stack[i].offset, "%s is never closed with %%endif%%", p.stack[i].raw)
+	}
+}
+
+// parseTag handles a tag, returning false if the text between the percent signs is not a tag and should be treated
+// as literal text
+func (p *parser) parseTag(raw string, offset int) bool {
+	body := raw[1 : len(raw)-1]
+	if body == "" || unicode.IsSpace(rune(body[0])) {
+		return false
+	}
+
+	switch {
+	case body == "if" || strings.HasPrefix(body, "if "):
+		p.openConditional(raw, offset, strings.TrimSpace(body[len("if"):]))
+	case body == "else":
+		p.elseConditional(raw, offset)
+	case body == "endif":
+		p.closeConditional(raw, offset)
+	case p.names[body]:
+		p.appendNode(&Placeholder{Raw: raw, Alternatives: []Value{{Name: body}}})
+	default:
+		placeholder, isTag, err := parsePlaceholder(raw)
+		if !isTag {
+			return false
+		}
+
+		if err != "" {
+			p.error(offset, "%s: %s", raw, err)
+			p.text.WriteString(raw)
+		} else {
+			p.appendNode(placeholder)
+		}
+	}
+
+	return true
+}
+
+func (p *parser) openConditional(raw string, offset int, condition string) {
+	var negated bool
+	if strings.HasPrefix(condition, "!") {
+		negated = true
+		condition = strings.TrimSpace(condition[1:])
+	}
+
+	value, err := parseValue(condition)
+	if condition == "" {
+		err = "missing condition, e.g. %if claimed%"
+	} else if err == "" && value.Literal != nil {
+		err = "the condition must be a placeholder"
+	}
+
+	if err != "" {
+		p.error(offset, "%s: %s", raw, err)
+		p.text.WriteString(raw)
+		return
+	}
+
+	conditional := &Conditional{
+		Condition: value,
+		Negated:   negated,
+	}
+
+	p.appendNode(conditional)
+	p.stack = append(p.stack, &openConditional{
+		conditional: conditional,
+		raw:         raw,
+		offset:      offset,
+	})
+}
+
+func (p *parser) elseConditional(raw string, offset int) {
+	if len(p.stack) == 0 {
+		p.error(offset, "%%else%% must be inside an %%if%% block")
+		p.text.WriteString(raw)
+		return
+	}
+
+	top := p.stack[len(p.stack)-1]
+	if top.inElse {
+		p.error(offset, "%s already has an %%else%%", top.raw)
+		p.text.WriteString(raw)
+		return
+	}
+
+	p.flushText()
+	top.inElse = true
+}
+
+func (p *parser) closeConditional(raw string, offset int) {
+	if len(p.stack) == 0 {
+		p.error(offset, "%%endif%% has no matching %%if%%")
+		p.text.WriteString(raw)
+		return
+	}
+
+	p.flushText()
+	p.stack = p.stack[:len(p.stack)-1]
+}
+
+func (p *parser) appendNode(node Node) {
+	p.flushText()
+
+	if len(p.stack) == 0 {
+		p.template.Nodes = append(p.template.Nodes, node)
+		return
+	}
+
+	top := p.stack[len(p.stack)-1]
+	if top.inElse {
+		top.conditional.Else = append(top.conditional.Else, node)
+	} else {
+		top.conditional.Then = append(top.conditional.Then, node)
+	}
+}
+
+func (p *parser) flushText() {
+	if p.text.Len() == 0 {
+		return
+	}
+
+	text := Text(p.text.String())
+	p.text.Reset()
+	p.appendNode(text)
+}
+
+func (p *parser) error(offset int, format string, args ...interface{}) {
+	line := strings.Count(p.input[:offset], "\n") + 1
+	lineStart := strings.LastIndexByte(p.input[:offset], '\n') + 1
+
+	p.errors = append(p.errors, &SyntaxError{
+		Offset:  offset,
+		Line:    line,
+		Column:  utf8.RuneCountInString(p.input[lineStart:offset]) + 1,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// parsePlaceholder parses a placeholder tag. isTag is false if the first segment is not a valid placeholder, in which
+// case the text is not a tag at all; err describes why a tag is invalid.
+func parsePlaceholder(raw string) (placeholder *Placeholder, isTag bool, err string) {
+	segments, ok := splitSegments(raw[1 : len(raw)-1])
+	if !ok {
+		return nil, strings.HasPrefix(raw, `%"`), "unterminated quote"
+	}
+
+	placeholder = &Placeholder{Raw: raw}
+	for i, segment := range segments {
+		segment = strings.TrimSpace(segment)
+
+		value, err := parseValue(segment)
+		if i == 0 {
+			if err != "" {
+				return nil, false, ""
+			}
+
+			placeholder.Alternatives = append(placeholder.Alternatives, value)
+			continue
+		}
+
+		if err != "" {
+			return nil, true, err
+		}
+
+		if value.Literal == nil {
+			if validate, isFilter := filters[value.Name]; isFilter {
+				if err := validate(value.Params); err != "" {
+					return nil, true, err
+				}
+
+				placeholder.Filters = append(placeholder.Filters, Filter{Name: value.Name, Params: value.Params})
+				continue
+			}
+		}
+
+		if len(placeholder.Filters) > 0 {
+			return nil, true, fmt.Sprintf("fallback %q must come before the filters", segment)
+		}
+
+		placeholder.Alternatives = append(placeholder.Alternatives, value)
+	}
+
+	return placeholder, true, ""
+}
+
+// splitSegments splits the body of a tag on the pipes that are not inside quotes
+func splitSegments(body string) ([]string, bool) {
+	var segments []string
+	var inQuote bool
+
+	start := 0
+	for i := 0; i < len(body); i++ {
+		switch body[i] {
+		case '"':
+			inQuote = !inQuote
+		case '|':
+			if !inQuote {
+				segments = append(segments, body[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(segments, body[start:]), !inQuote
+}
+
+func parseValue(s string) (Value, string) {
+	if s == "" {
+		return Value{}, "empty fallback"
+	}
+
+	if strings.HasPrefix(s, `"`) {
+		if len(s) < 2 || !strings.HasSuffix(s, `"`) || strings.Count(s, `"`) != 2 {
+			return Value{}, fmt.Sprintf("invalid literal %s", s)
+		}
+
+		literal := s[1 : len(s)-1]
+		return Value{Literal: &literal}, ""
+	}
+
+	split := strings.Split(s, ":")
+	if !isValidName(split[0]) {
+		return Value{}, fmt.Sprintf("invalid placeholder name %q", split[0])
+	}
+
+	return Value{Name: split[0], Params: split[1:]}, ""
+}
+
+// isValidName reports whether name matches [a-z_][a-z0-9_]*, the form that every placeholder name takes
+func isValidName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for i, r := range name {
+		if !(r >= 'a' && r <= 'z') && r != '_' && (i == 0 || !(r >= '0' && r <= '9')) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filters maps the name of each filter to a function that validates its parameters
+var filters = map[string]func(params []string) string{
+	"upper": noParams("upper"),
+	"lower": noParams("lower"),
+	"truncate": func(params []string) string {
+		if len(params) != 1 {
+			return "truncate takes one parameter, the maximum length, e.g. truncate:20"
+		}
+
+		if length, err := strconv.Atoi(params[0]); err != nil || length <= 0 {
+			return fmt.Sprintf("truncate length %q must be a positive number", params[0])
+		}
+
+		return ""
+	},
+}
+
+func noParams(name string) func(params []string) string {
+	return func(params []string) string {
+		if len(params) > 0 {
+			return fmt.Sprintf("%s does not take any parameters", name)
+		}
+
+		return ""
+	}
+}
@@ -0,0 +1,93 @@
+package placeholders
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mapResolver map[string]string
+
+func (r mapResolver) Resolve(name string, params []string) (string, bool) {
+	if len(params) > 0 {
+		name += ":" + strings.Join(params, ":")
+	}
+
+	value, ok := r[name]
+	return value, ok
+}
+
+func renderString(t *testing.T, s string, resolver Resolver) string {
+	return Render(mustParse(t, s), resolver)
+}
+
+func TestRender(t *testing.T) {
+	resolver := mapResolver{
+		"username":       "ryan",
+		"nickname":       "",
+		"claimed_by":     "",
+		"server":         "Tickets Server",
+		"form:Order: Id": "1234",
+	}
+
+	require.Equal(t, "Hi ryan", renderString(t, "Hi %nickname|username%", resolver))
+	require.Equal(t, "Hi friend", renderString(t, `Hi %nickname|claimed_by|"friend"%`, resolver))
+	require.Equal(t, "RYAN tickets", renderString(t, "%username|upper% %server|lower|truncate:7%", resolver))
+	require.Equal(t, "Order 1234", renderString(t, "Order %form:Order: Id%", resolver))
+	require.Equal(t, "50% off, %unknown% stays, %user% is escaped", renderString(t, `50% off, %unknown% stays, \%user\% is escaped`, resolver))
+	require.Equal(t, "Unclaimed.", renderString(t, "%if claimed_by%Claimed by %claimed_by%%else%Unclaimed%endif%.", resolver))
+	require.Equal(t, "Has a name: ryan", renderString(t, "%if !nickname%%if username%Has a name: %username%%endif%%endif%", resolver))
+	require.Equal(t, []string{"claimed_by", "username", "nickname"}, mustParse(t, "%if claimed_by%%username%%else%%nickname|\"x\"%%endif%").Names())
+}
+
+func TestParseWithNames(t *testing.T) {
+	resolver := mapResolver{
+		"username":     "ryan",
+		"Order-ID":     "1234",
+		"2FA Code":     "5678",
+		"game:Version": "1.21",
+	}
+
+	names := []string{"Order-ID", "2FA Code", "game:Version"}
+
+	template, err := ParseWithNames("%username%: %Order-ID% %2FA Code% %game:Version% %order-id%", names)
+	require.NoError(t, err)
+	require.Equal(t, "ryan: 1234 5678 1.21 %order-id%", Render(template, resolver))
+
+	// Names that are not valid placeholder names are left as text without them
+	template, err = Parse("%Order-ID%")
+	require.NoError(t, err)
+	require.Equal(t, "%Order-ID%", Render(template, resolver))
+}
+
+func mustParse(t *testing.T, s string) Template {
+	template, err := Parse(s)
+	require.NoError(t, err)
+	return template
+}
+
+func TestParseErrors(t *testing.T) {
+	for s, message := range map[string]string{
+		"%if claimed%unclosed":            "line 1, column 1: %if claimed% is never closed with %endif%",
+		"text\n  %endif%":                 "line 2, column 3: %endif% has no matching %if%",
+		"%else%":                          "line 1, column 1: %else% must be inside an %if% block",
+		"%username|truncate%":             "line 1, column 1: %username|truncate%: truncate takes one parameter, the maximum length, e.g. truncate:20",
+		"%username|truncate:abc%":         `line 1, column 1: %username|truncate:abc%: truncate length "abc" must be a positive number`,
+		"%username|upper|nickname%":       `line 1, column 1: %username|upper|nickname%: fallback "nickname" must come before the filters`,
+		"%username|%":                     "line 1, column 1: %username|%: empty fallback",
+		"%if% text":                       "line 1, column 1: %if%: missing condition, e.g. %if claimed%",
+		"%if claimed%%else%%else%%endif%": "line 1, column 19: %if claimed% already has an %else%",
+		"%username|Nickname%":             `line 1, column 1: %username|Nickname%: invalid placeholder name "Nickname"`,
+		"%username|2fa%":                  `line 1, column 1: %username|2fa%: invalid placeholder name "2fa"`,
+	} {
+		template, err := Parse(s)
+		require.EqualError(t, err, message, s)
+
+		var syntaxErrors SyntaxErrors
+		require.ErrorAs(t, err, &syntaxErrors)
+
+		// Invalid templates can still be rendered
+		Render(template, mapResolver{})
+	}
+}
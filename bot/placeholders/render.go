@@ -0,0 +1,141 @@
+package placeholders
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Resolver provides the values of placeholders
+type Resolver interface {
+	// Resolve returns the value of the placeholder, or false if there is no placeholder with the name
+	Resolve(name string, params []string) (string, bool)
+}
+
+// ConditionResolver can be implemented by a Resolver for placeholders whose value is never empty, such as %claimed%,
+// which would otherwise always be true when used as a condition
+type ConditionResolver interface {
+	// Condition returns whether the condition is true, or false for ok if the placeholder's value should be used
+	Condition(name string, params []string) (value bool, ok bool)
+}
+
+// Names returns the names of the placeholders used in the template, including in conditions, without duplicates
+func (t Template) Names() []string {
+	var names []string
+	seen := make(map[string]bool)
+
+	add := func(value Value) {
+		if value.Literal == nil && !seen[value.Name] {
+			seen[value.Name] = true
+			names = append(names, value.Name)
+		}
+	}
+
+	var walk func(nodes []Node)
+	walk = func(nodes []Node) {
+		for _, node := range nodes {
+			switch node := node.(type) {
+			case *Placeholder:
+				for _, value := range node.Alternatives {
+					add(value)
+				}
+			case *Conditional:
+				add(node.Condition)
+				walk(node.Then)
+				walk(node.Else)
+			}
+		}
+	}
+
+	walk(t.Nodes)
+	return names
+}
+
+// Render substitutes the placeholders in the template. Placeholders that the resolver does not know are rendered as
+// they were written.
+func Render(t Template, resolver Resolver) string {
+	var builder strings.Builder
+	render(&builder, t.Nodes, resolver)
+	return builder.String()
+}
+
+func render(builder *strings.Builder, nodes []Node, resolver Resolver) {
+	for _, node := range nodes {
+		switch node := node.(type) {
+		case Text:
+			builder.WriteString(string(node))
+		case *Placeholder:
+			builder.WriteString(renderPlaceholder(node, resolver))
+		case *Conditional:
+			if evaluateCondition(node, resolver) {
+				render(builder, node.Then, resolver)
+			} else {
+				render(builder, node.Else, resolver)
+			}
+		}
+	}
+}
+
+func renderPlaceholder(placeholder *Placeholder, resolver Resolver) string {
+	var value string
+	var found bool
+	for _, alternative := range placeholder.Alternatives {
+		resolved, ok := resolveValue(alternative, resolver)
+		if !ok {
+			continue
+		}
+
+		found = true
+		if resolved != "" {
+			value = resolved
+			break
+		}
+	}
+
+	if !found {
+		return placeholder.Raw
+	}
+
+	for _, filter := range placeholder.Filters {
+		value = applyFilter(filter, value)
+	}
+
+	return value
+}
+
+func resolveValue(value Value, resolver Resolver) (string, bool) {
+	if value.Literal != nil {
+		return *value.Literal, true
+	}
+
+	return resolver.Resolve(value.Name, value.Params)
+}
+
+func evaluateCondition(conditional *Conditional, resolver Resolver) bool {
+	if conditionResolver, ok := resolver.(ConditionResolver); ok {
+		if result, ok := conditionResolver.Condition(conditional.Condition.Name, conditional.Condition.Params); ok {
+			return result != conditional.Negated
+		}
+	}
+
+	value, _ := resolveValue(conditional.Condition, resolver)
+	return (strings.TrimSpace(value) != "") != conditional.Negated
+}
+
+func applyFilter(filter Filter, value string) string {
+	switch filter.Name {
+	case "upper":
+		return strings.ToUpper(value)
+	case "lower":
+		return strings.ToLower(value)
+	case "truncate":
+		// The length is checked when the template is parsed
+		length, _ := strconv.Atoi(filter.Params[0])
+		if runes := []rune(value); len(runes) > length {
+			return string(runes[:length])
+		}
+
+		return value
+	default:
+		return value
+	}
+}
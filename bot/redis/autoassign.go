@@ -0,0 +1,20 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+)
+
+func autoAssignTurnKey(guildId uint64, panelId int) string {
+	return fmt.Sprintf("tickets:autoassign:turn:%d:%d", guildId, panelId)
+}
+
+// NextAutoAssignTurn atomically advances the panel's round-robin rotation, returning a counter that increases by
+// one for each ticket assigned, so that concurrent tickets are given different turns
+func NextAutoAssignTurn(ctx context.Context, guildId uint64, panelId int) (int64, error) {
+	return Client.Incr(ctx, autoAssignTurnKey(guildId, panelId)).Result()
+}
+
+func ResetAutoAssignTurn(ctx context.Context, guildId uint64, panelId int) error {
+	return Client.Del(ctx, autoAssignTurnKey(guildId, panelId)).Err()
+}
@@ -0,0 +1,154 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// DeadLetter is a gateway event payload that could not be processed, kept so that it can be replayed later
+type DeadLetter struct {
+	Id        string    `json:"id"`
+	EventType string    `json:"event_type,omitempty"`
+	Payload   []byte    `json:"payload"`
+	Error     string    `json:"error"`
+	Attempts  int       `json:"attempts"`
+	FailedAt  time.Time `json:"failed_at"`
+}
+
+const (
+	deadLetterIndexKey = "tickets:worker:deadletter"
+	deadLetterDataKey  = "tickets:worker:deadletter:entries"
+)
+
+// PushDeadLetter stores the entry, evicting the oldest entries if there are more than maxEntries stored
+func PushDeadLetter(ctx context.Context, entry DeadLetter, maxEntries int64) error {
+	marshalled, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	tx := Client.TxPipeline()
+	tx.HSet(ctx, deadLetterDataKey, entry.Id, marshalled)
+	tx.ZAdd(ctx, deadLetterIndexKey, &redis.Z{
+		Score:  float64(entry.FailedAt.UnixMilli()),
+		Member: entry.Id,
+	})
+	count := tx.ZCard(ctx, deadLetterIndexKey)
+
+	if _, err := tx.Exec(ctx); err != nil {
+		return err
+	}
+
+	if maxEntries <= 0 || count.Val() <= maxEntries {
+		return nil
+	}
+
+	evicted, err := Client.ZRange(ctx, deadLetterIndexKey, 0, count.Val()-maxEntries-1).Result()
+	if err != nil {
+		return err
+	}
+
+	return DeleteDeadLetters(ctx, evicted...)
+}
+
+// UpdateDeadLetter overwrites an existing entry, without changing its position in the queue
+func UpdateDeadLetter(ctx context.Context, entry DeadLetter) error {
+	marshalled, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	return Client.HSet(ctx, deadLetterDataKey, entry.Id, marshalled).Err()
+}
+
+func GetDeadLetter(ctx context.Context, id string) (DeadLetter, bool, error) {
+	raw, err := Client.HGet(ctx, deadLetterDataKey, id).Bytes()
+	if err != nil {
+		if errors.Is(err, ErrNil) {
+			return DeadLetter{}, false, nil
+		}
+
+		return DeadLetter{}, false, err
+	}
+
+	var entry DeadLetter
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return DeadLetter{}, false, err
+	}
+
+	return entry, true, nil
+}
+
+// GetDeadLetters returns up to limit entries, oldest first, that failed within [since, until].
+// A zero since or until leaves that end of the range open.
+func GetDeadLetters(ctx context.Context, since, until time.Time, limit int64) ([]DeadLetter, error) {
+	ids, err := Client.ZRangeByScore(ctx, deadLetterIndexKey, &redis.ZRangeBy{
+		Min:   formatScore(since, "-inf"),
+		Max:   formatScore(until, "+inf"),
+		Count: limit,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	values, err := Client.HMGet(ctx, deadLetterDataKey, ids...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]DeadLetter, 0, len(values))
+	for _, value := range values {
+		raw, ok := value.(string)
+		if !ok {
+			continue // Evicted between the two calls
+		}
+
+		var entry DeadLetter
+		if err := json.Unmarshal([]byte(raw), &entry); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func CountDeadLetters(ctx context.Context) (int64, error) {
+	return Client.ZCard(ctx, deadLetterIndexKey).Result()
+}
+
+func DeleteDeadLetters(ctx context.Context, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	members := make([]interface{}, len(ids))
+	for i, id := range ids {
+		members[i] = id
+	}
+
+	tx := Client.TxPipeline()
+	tx.ZRem(ctx, deadLetterIndexKey, members...)
+	tx.HDel(ctx, deadLetterDataKey, ids...)
+
+	_, err := tx.Exec(ctx)
+	return err
+}
+
+func formatScore(t time.Time, fallback string) string {
+	if t.IsZero() {
+		return fallback
+	}
+
+	return strconv.FormatInt(t.UnixMilli(), 10)
+}
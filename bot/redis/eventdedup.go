@@ -0,0 +1,21 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func eventDedupKey(eventType, entityId string) string {
+	return fmt.Sprintf("tickets:worker:dedup:%s:%s", eventType, entityId)
+}
+
+// MarkEventProcessed returns true if the event has not been processed within the last ttl, marking it as processed
+func MarkEventProcessed(ctx context.Context, eventType, entityId string, ttl time.Duration) (bool, error) {
+	return Client.SetNX(ctx, eventDedupKey(eventType, entityId), 1, ttl).Result()
+}
+
+// UnmarkEventProcessed removes the mark, so that the event is processed again if it is redelivered
+func UnmarkEventProcessed(ctx context.Context, eventType, entityId string) error {
+	return Client.Del(ctx, eventDedupKey(eventType, entityId)).Err()
+}
@@ -0,0 +1,56 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const FormSessionExpiry = time.Minute * 30
+
+// FormSession holds a user's answers to the steps of a multi-step form that they have already submitted, by form
+// input ID
+type FormSession struct {
+	PanelId    int            `json:"panel_id"`
+	NextFormId int            `json:"next_form_id"`
+	FormIds    []int          `json:"form_ids"`
+	Answers    map[int]string `json:"answers"`
+}
+
+func formSessionKey(guildId, userId uint64) string {
+	return fmt.Sprintf("tickets:forms:session:%d:%d", guildId, userId)
+}
+
+func GetFormSession(ctx context.Context, guildId, userId uint64) (FormSession, bool, error) {
+	raw, err := Client.Get(ctx, formSessionKey(guildId, userId)).Bytes()
+	if err != nil {
+		if errors.Is(err, ErrNil) {
+			return FormSession{}, false, nil
+		}
+
+		return FormSession{}, false, err
+	}
+
+	var session FormSession
+	if err := json.Unmarshal(raw, &session); err != nil {
+		return FormSession{}, false, err
+	}
+
+	return session, true, nil
+}
+
+// SetFormSession stores the user's partial answers, replacing any form they had previously started
+func SetFormSession(ctx context.Context, guildId, userId uint64, session FormSession) error {
+	marshalled, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	return Client.Set(ctx, formSessionKey(guildId, userId), marshalled, FormSessionExpiry).Err()
+}
+
+func DeleteFormSession(ctx context.Context, guildId, userId uint64) error {
+	return Client.Del(ctx, formSessionKey(guildId, userId)).Err()
+}
@@ -0,0 +1,46 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// queuePollTimeout bounds how long a listener takes to notice that it has been stopped
+const queuePollTimeout = time.Second
+
+// ListenQueue pops JSON encoded items from the list stored at key and sends them to ch, until ctx is
+// cancelled. ch is closed once the listener has stopped. Every popped item is delivered, so the receiver
+// should keep reading until ch is closed.
+func ListenQueue[T any](ctx context.Context, key string, ch chan<- T) {
+	defer close(ch)
+
+	for ctx.Err() == nil {
+		// Don't pass ctx, a cancellation must not abort a pop that has already taken an item off the list
+		res, err := Client.BLPop(context.Background(), queuePollTimeout, key).Result()
+		if err != nil {
+			if !errors.Is(err, ErrNil) {
+				// Back off instead of spinning if Redis is unavailable
+				select {
+				case <-ctx.Done():
+				case <-time.After(time.Second):
+				}
+			}
+
+			continue
+		}
+
+		// res = [list_name, content]
+		if len(res) < 2 {
+			continue
+		}
+
+		var item T
+		if err := json.Unmarshal([]byte(res[1]), &item); err != nil {
+			continue
+		}
+
+		ch <- item
+	}
+}
@@ -0,0 +1,21 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func requestNonceKey(nonce string) string {
+	return fmt.Sprintf("tickets:worker:requestnonce:%s", nonce)
+}
+
+// TakeRequestNonce returns true if the nonce has not been seen within the last ttl, marking it as seen
+func TakeRequestNonce(ctx context.Context, nonce string, ttl time.Duration) (bool, error) {
+	return Client.SetNX(ctx, requestNonceKey(nonce), 1, ttl).Result()
+}
+
+// ReleaseRequestNonce allows the nonce to be used again, for requests that were rejected without being processed
+func ReleaseRequestNonce(ctx context.Context, nonce string) error {
+	return Client.Del(ctx, requestNonceKey(nonce)).Err()
+}
@@ -0,0 +1,189 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Timer is a durable, one-shot timer shared by all workers. Each timer is identified by its kind and an ID that
+// is unique within that kind, so scheduling a timer that already exists moves it.
+type Timer struct {
+	Kind    string
+	Id      string
+	FireAt  time.Time
+	Payload []byte
+	// Attempts is the number of times the timer has previously been handled without success
+	Attempts int
+}
+
+const (
+	timerIndexKey    = "tickets:timers"
+	timerInFlightKey = "tickets:timers:inflight"
+	timerDataKey     = "tickets:timers:data"
+	timerAttemptsKey = "tickets:timers:attempts"
+)
+
+var timerKeys = []string{timerIndexKey, timerInFlightKey, timerDataKey, timerAttemptsKey}
+
+// claimTimersScript leases due timers to the caller until ARGV[3], so that only one worker processes each. A timer
+// stays in the in-flight set until it is acknowledged, and if its lease expires first, for example because the
+// worker crashed, it becomes due again.
+var claimTimersScript = redis.NewScript(`
+local expired = redis.call("ZRANGEBYSCORE", KEYS[2], "-inf", ARGV[1])
+for _, member in ipairs(expired) do
+	redis.call("ZREM", KEYS[2], member)
+	redis.call("ZADD", KEYS[1], "NX", ARGV[1], member)
+end
+
+local members = redis.call("ZRANGEBYSCORE", KEYS[1], "-inf", ARGV[1], "WITHSCORES", "LIMIT", 0, ARGV[2])
+local res = {}
+
+for i = 1, #members, 2 do
+	local member = members[i]
+	redis.call("ZREM", KEYS[1], member)
+	redis.call("ZADD", KEYS[2], ARGV[3], member)
+
+	table.insert(res, member)
+	table.insert(res, members[i + 1])
+	table.insert(res, redis.call("HGET", KEYS[3], member) or "")
+	table.insert(res, redis.call("HGET", KEYS[4], member) or "0")
+end
+
+return res
+`)
+
+// ackTimerScript releases the lease on a handled timer. Its data is kept if the handler scheduled it again.
+var ackTimerScript = redis.NewScript(`
+redis.call("ZREM", KEYS[2], ARGV[1])
+if not redis.call("ZSCORE", KEYS[1], ARGV[1]) then
+	redis.call("HDEL", KEYS[3], ARGV[1])
+	redis.call("HDEL", KEYS[4], ARGV[1])
+end
+
+return 0
+`)
+
+// retryTimerScript releases the lease on a failed timer and schedules it to fire again at ARGV[2], unless it was
+// cancelled or scheduled again in the meantime
+var retryTimerScript = redis.NewScript(`
+if redis.call("ZREM", KEYS[2], ARGV[1]) == 0 or redis.call("ZSCORE", KEYS[1], ARGV[1]) then
+	return 0
+end
+
+redis.call("HINCRBY", KEYS[4], ARGV[1], 1)
+redis.call("ZADD", KEYS[1], ARGV[2], ARGV[1])
+return 1
+`)
+
+func timerMember(kind, id string) string {
+	return fmt.Sprintf("%s:%s", kind, id)
+}
+
+func ScheduleTimer(ctx context.Context, kind, id string, fireAt time.Time, payload []byte) error {
+	member := timerMember(kind, id)
+
+	tx := Client.TxPipeline()
+	tx.HSet(ctx, timerDataKey, member, payload)
+	tx.HDel(ctx, timerAttemptsKey, member)
+	tx.ZAdd(ctx, timerIndexKey, &redis.Z{
+		Score:  float64(fireAt.UnixMilli()),
+		Member: member,
+	})
+
+	_, err := tx.Exec(ctx)
+	return err
+}
+
+// CancelTimer removes the timer, returning true if it had not yet fired. A timer that is being handled is not
+// retried if its handler fails.
+func CancelTimer(ctx context.Context, kind, id string) (bool, error) {
+	member := timerMember(kind, id)
+
+	tx := Client.TxPipeline()
+	removed := tx.ZRem(ctx, timerIndexKey, member)
+	tx.ZRem(ctx, timerInFlightKey, member)
+	tx.HDel(ctx, timerDataKey, member)
+	tx.HDel(ctx, timerAttemptsKey, member)
+
+	if _, err := tx.Exec(ctx); err != nil {
+		return false, err
+	}
+
+	return removed.Val() > 0, nil
+}
+
+// AckTimer marks a claimed timer as handled
+func AckTimer(ctx context.Context, kind, id string) error {
+	return ackTimerScript.Run(ctx, Client, timerKeys, timerMember(kind, id)).Err()
+}
+
+// RetryTimer schedules a claimed timer whose handler failed to fire again at retryAt
+func RetryTimer(ctx context.Context, kind, id string, retryAt time.Time) error {
+	return retryTimerScript.Run(ctx, Client, timerKeys, timerMember(kind, id), retryAt.UnixMilli()).Err()
+}
+
+// GetTimerFireTime returns when the timer is due to fire, and false if it is not pending
+func GetTimerFireTime(ctx context.Context, kind, id string) (time.Time, bool, error) {
+	score, err := Client.ZScore(ctx, timerIndexKey, timerMember(kind, id)).Result()
+	if err != nil {
+		if err == ErrNil {
+			return time.Time{}, false, nil
+		}
+
+		return time.Time{}, false, err
+	}
+
+	return time.UnixMilli(int64(score)), true, nil
+}
+
+// ClaimDueTimers leases up to limit timers that are due to fire at or before now. Each timer must be passed to
+// AckTimer or RetryTimer before the lease expires, otherwise it will be claimed again.
+func ClaimDueTimers(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]Timer, error) {
+	res, err := claimTimersScript.Run(ctx, Client, timerKeys, now.UnixMilli(), limit, now.Add(lease).UnixMilli()).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	values, ok := res.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("claim timers returned %v, not an array", res)
+	}
+
+	timers := make([]Timer, 0, len(values)/4)
+	for i := 0; i+3 < len(values); i += 4 {
+		member, _ := values[i].(string)
+		rawScore, _ := values[i+1].(string)
+		data, _ := values[i+2].(string)
+		attempts, _ := values[i+3].(string)
+
+		kind, id, ok := strings.Cut(member, ":")
+		if !ok {
+			continue
+		}
+
+		score, err := strconv.ParseFloat(rawScore, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		timers = append(timers, Timer{
+			Kind:     kind,
+			Id:       id,
+			FireAt:   time.UnixMilli(int64(score)),
+			Payload:  []byte(data),
+			Attempts: attemptsOrZero(attempts),
+		})
+	}
+
+	return timers, nil
+}
+
+func attemptsOrZero(raw string) int {
+	attempts, _ := strconv.Atoi(raw)
+	return attempts
+}
@@ -0,0 +1,31 @@
+package listeners
+
+import (
+	"context"
+
+	"github.com/TicketsBot-cloud/common/rpc"
+)
+
+// AsyncListener handles each message on a new goroutine, so that a slow listener does not hold up the consumer
+// when it is running with a concurrency of 1.
+type AsyncListener struct {
+	rpc.Listener
+}
+
+var _ rpc.Listener = (*AsyncListener)(nil)
+
+func NewAsyncListener(listener rpc.Listener) *AsyncListener {
+	return &AsyncListener{
+		Listener: listener,
+	}
+}
+
+func (l *AsyncListener) HandleMessage(_ context.Context, message []byte) {
+	go func() {
+		// The consumer cancels ctx as soon as HandleMessage returns, so build a new one
+		ctx, cancel := l.Listener.BuildContext()
+		defer cancel()
+
+		l.Listener.HandleMessage(ctx, message)
+	}()
+}
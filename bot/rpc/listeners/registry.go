@@ -0,0 +1,88 @@
+package listeners
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/TicketsBot-cloud/common/rpc"
+	"github.com/TicketsBot-cloud/gdl/cache"
+	"go.uber.org/zap"
+)
+
+type (
+	Factory func(cache *cache.PgCache, logger *zap.Logger) rpc.Listener
+
+	Registration struct {
+		Name    string
+		Topic   string
+		Factory Factory
+	}
+)
+
+var registry = make(map[string]Registration)
+
+// Register makes a listener available to be enabled by name from config. It panics if the name is already taken.
+func Register(name, topic string, factory Factory) {
+	if _, ok := registry[name]; ok {
+		panic(fmt.Sprintf("rpc listener %s registered twice", name))
+	}
+
+	registry[name] = Registration{
+		Name:    name,
+		Topic:   topic,
+		Factory: factory,
+	}
+}
+
+func init() {
+	Register("categoryupdate", "tickets.rpc.categoryupdate", func(cache *cache.PgCache, logger *zap.Logger) rpc.Listener {
+		return NewTicketStatusUpdater(cache, logger)
+	})
+}
+
+// Registered returns the names of all registered listeners, sorted
+func Registered() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// Build constructs the named listeners, keyed by the topic they consume. topicOverrides maps a listener name to
+// the topic it should consume instead of its default.
+func Build(
+	cache *cache.PgCache,
+	logger *zap.Logger,
+	names []string,
+	topicOverrides map[string]string,
+) (map[string]rpc.Listener, error) {
+	for name := range topicOverrides {
+		if _, ok := registry[name]; !ok {
+			return nil, fmt.Errorf("topic override for unknown rpc listener %s", name)
+		}
+	}
+
+	listeners := make(map[string]rpc.Listener, len(names))
+	for _, name := range names {
+		registration, ok := registry[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown rpc listener %s (registered listeners: %v)", name, Registered())
+		}
+
+		topic := registration.Topic
+		if override, ok := topicOverrides[name]; ok {
+			topic = override
+		}
+
+		if _, ok := listeners[topic]; ok {
+			return nil, fmt.Errorf("rpc listener %s consumes topic %s, which is already in use", name, topic)
+		}
+
+		listeners[topic] = registration.Factory(cache, logger.With(zap.String("listener", name)))
+	}
+
+	return listeners, nil
+}
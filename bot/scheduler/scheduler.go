@@ -0,0 +1,154 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/sentry"
+	"github.com/TicketsBot-cloud/worker/bot/metrics/prometheus"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/config"
+	"go.uber.org/zap"
+)
+
+// Handler processes a timer once it has fired. If the handler returns an error, the timer is retried with
+// exponential backoff, up to the configured number of attempts, so handlers must be safe to run more than once.
+type Handler func(ctx context.Context, timer redis.Timer) error
+
+const (
+	handlerTimeout = time.Minute
+	// leaseTimeout is how long a claimed timer is held before another worker may claim it, and must be longer than
+	// handlerTimeout so that a timer is only claimed again if the worker handling it has died
+	leaseTimeout = handlerTimeout * 2
+)
+
+var (
+	handlersMu sync.RWMutex
+	handlers   = make(map[string]Handler)
+)
+
+// Register sets the handler for timers of the given kind. It panics if the kind already has a handler.
+func Register(kind string, handler Handler) {
+	handlersMu.Lock()
+	defer handlersMu.Unlock()
+
+	if _, ok := handlers[kind]; ok {
+		panic(fmt.Sprintf("timer handler for %s registered twice", kind))
+	}
+
+	handlers[kind] = handler
+}
+
+// Schedule creates the timer, or moves it if it already exists. The payload is marshalled as JSON.
+func Schedule(ctx context.Context, kind, id string, fireAt time.Time, payload any) error {
+	marshalled, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return redis.ScheduleTimer(ctx, kind, id, fireAt, marshalled)
+}
+
+// Cancel removes the timer, returning true if it had not yet fired
+func Cancel(ctx context.Context, kind, id string) (bool, error) {
+	return redis.CancelTimer(ctx, kind, id)
+}
+
+// Run polls for due timers until ctx is cancelled, then waits for in-flight handlers to finish
+func Run(ctx context.Context, logger *zap.Logger) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	ticker := time.NewTicker(config.Conf.Timers.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// Use a fresh context so that a claim is not abandoned part way through on shutdown
+		claimCtx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		timers, err := redis.ClaimDueTimers(claimCtx, time.Now(), config.Conf.Timers.BatchSize, leaseTimeout)
+		cancel()
+
+		if err != nil {
+			logger.Error("Failed to claim due timers", zap.Error(err))
+			continue
+		}
+
+		for _, timer := range timers {
+			timer := timer
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				fire(logger, timer)
+			}()
+		}
+	}
+}
+
+func fire(logger *zap.Logger, timer redis.Timer) {
+	handlersMu.RLock()
+	handler, ok := handlers[timer.Kind]
+	handlersMu.RUnlock()
+
+	if !ok {
+		logger.Warn("No handler registered for timer", zap.String("kind", timer.Kind), zap.String("id", timer.Id))
+		prometheus.FiredTimers.WithLabelValues(timer.Kind, "unhandled").Inc()
+		ack(logger, timer)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), handlerTimeout)
+	err := handler(ctx, timer)
+	cancel()
+
+	if err == nil {
+		prometheus.FiredTimers.WithLabelValues(timer.Kind, "success").Inc()
+		ack(logger, timer)
+		return
+	}
+
+	logger.Error("Timer handler failed", zap.String("kind", timer.Kind), zap.String("id", timer.Id), zap.Int("attempt", timer.Attempts+1), zap.Error(err))
+	sentry.Error(err)
+
+	if timer.Attempts+1 >= config.Conf.Timers.MaxAttempts {
+		logger.Error("Giving up on timer", zap.String("kind", timer.Kind), zap.String("id", timer.Id))
+		prometheus.FiredTimers.WithLabelValues(timer.Kind, "error").Inc()
+		ack(logger, timer)
+		return
+	}
+
+	prometheus.FiredTimers.WithLabelValues(timer.Kind, "retry").Inc()
+
+	retryCtx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	if err := redis.RetryTimer(retryCtx, timer.Kind, timer.Id, time.Now().Add(retryBackoff(timer.Attempts))); err != nil {
+		// The lease will expire and the timer will be claimed again
+		logger.Error("Failed to reschedule timer", zap.String("kind", timer.Kind), zap.String("id", timer.Id), zap.Error(err))
+	}
+}
+
+// ack releases the lease on a timer that will not be retried. If this fails, the timer is claimed again once the
+// lease expires.
+func ack(logger *zap.Logger, timer redis.Timer) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	if err := redis.AckTimer(ctx, timer.Kind, timer.Id); err != nil {
+		logger.Error("Failed to acknowledge timer", zap.String("kind", timer.Kind), zap.String("id", timer.Id), zap.Error(err))
+	}
+}
+
+// retryBackoff doubles the configured backoff for each previous attempt
+func retryBackoff(attempts int) time.Duration {
+	return config.Conf.Timers.RetryBackoff << attempts
+}
@@ -0,0 +1,190 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/config"
+	"github.com/alicebob/miniredis/v2"
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestClaimDueTimers(t *testing.T) {
+	setupSchedulerTest(t)
+
+	ctx := context.Background()
+	now := time.Now()
+
+	require.NoError(t, Schedule(ctx, "test_claim", "due", now.Add(-time.Second), "payload"))
+	require.NoError(t, Schedule(ctx, "test_claim", "later", now.Add(time.Hour), "payload"))
+
+	timers, err := redis.ClaimDueTimers(ctx, now, 10, leaseTimeout)
+	require.NoError(t, err)
+	require.Len(t, timers, 1)
+	require.Equal(t, "test_claim", timers[0].Kind)
+	require.Equal(t, "due", timers[0].Id)
+	require.Equal(t, `"payload"`, string(timers[0].Payload))
+	require.Zero(t, timers[0].Attempts)
+
+	// A leased timer is not claimed by another worker
+	timers, err = redis.ClaimDueTimers(ctx, now, 10, leaseTimeout)
+	require.NoError(t, err)
+	require.Empty(t, timers)
+
+	// Until the lease expires without the timer being acknowledged
+	timers, err = redis.ClaimDueTimers(ctx, now.Add(leaseTimeout+time.Second), 10, leaseTimeout)
+	require.NoError(t, err)
+	require.Len(t, timers, 1)
+	require.Equal(t, "due", timers[0].Id)
+	require.Equal(t, `"payload"`, string(timers[0].Payload))
+}
+
+func TestFireAcknowledgesOnSuccess(t *testing.T) {
+	server := setupSchedulerTest(t)
+
+	var fired []string
+	Register("test_success", func(ctx context.Context, timer redis.Timer) error {
+		fired = append(fired, timer.Id)
+		return nil
+	})
+
+	timer := scheduleAndClaim(t, "test_success", "1")
+	fire(zap.NewNop(), timer)
+
+	require.Equal(t, []string{"1"}, fired)
+	requireTimerGone(t, server, "test_success:1")
+}
+
+func TestFireKeepsTimerScheduledByHandler(t *testing.T) {
+	setupSchedulerTest(t)
+
+	fireAt := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+	Register("test_reschedule", func(ctx context.Context, timer redis.Timer) error {
+		return Schedule(ctx, timer.Kind, timer.Id, fireAt, "next")
+	})
+
+	timer := scheduleAndClaim(t, "test_reschedule", "1")
+	fire(zap.NewNop(), timer)
+
+	pendingAt, ok, err := redis.GetTimerFireTime(context.Background(), "test_reschedule", "1")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, fireAt.Equal(pendingAt))
+
+	timers, err := redis.ClaimDueTimers(context.Background(), fireAt, 10, leaseTimeout)
+	require.NoError(t, err)
+	require.Len(t, timers, 1)
+	require.Equal(t, `"next"`, string(timers[0].Payload))
+}
+
+func TestFireRetriesOnError(t *testing.T) {
+	server := setupSchedulerTest(t)
+	config.Conf.Timers.MaxAttempts = 2
+
+	var attempts int
+	Register("test_retry", func(ctx context.Context, timer redis.Timer) error {
+		attempts++
+		return errors.New("failed")
+	})
+
+	timer := scheduleAndClaim(t, "test_retry", "1")
+	fire(zap.NewNop(), timer)
+
+	fireAt, ok, err := redis.GetTimerFireTime(context.Background(), "test_retry", "1")
+	require.NoError(t, err)
+	require.True(t, ok, "failed timer should be rescheduled")
+	require.True(t, fireAt.After(time.Now()), "failed timer should back off")
+
+	timers, err := redis.ClaimDueTimers(context.Background(), fireAt, 10, leaseTimeout)
+	require.NoError(t, err)
+	require.Len(t, timers, 1)
+	require.Equal(t, 1, timers[0].Attempts)
+	require.Equal(t, `"payload"`, string(timers[0].Payload))
+
+	// The last attempt gives up
+	fire(zap.NewNop(), timers[0])
+	require.Equal(t, 2, attempts)
+	requireTimerGone(t, server, "test_retry:1")
+}
+
+func TestCancel(t *testing.T) {
+	server := setupSchedulerTest(t)
+
+	ctx := context.Background()
+	require.NoError(t, Schedule(ctx, "test_cancel", "1", time.Now(), "payload"))
+
+	cancelled, err := Cancel(ctx, "test_cancel", "1")
+	require.NoError(t, err)
+	require.True(t, cancelled)
+	requireTimerGone(t, server, "test_cancel:1")
+
+	timers, err := redis.ClaimDueTimers(ctx, time.Now(), 10, leaseTimeout)
+	require.NoError(t, err)
+	require.Empty(t, timers)
+
+	cancelled, err = Cancel(ctx, "test_cancel", "1")
+	require.NoError(t, err)
+	require.False(t, cancelled)
+}
+
+func TestCancelInFlight(t *testing.T) {
+	server := setupSchedulerTest(t)
+
+	Register("test_cancel_in_flight", func(ctx context.Context, timer redis.Timer) error {
+		cancelled, err := Cancel(ctx, timer.Kind, timer.Id)
+		require.NoError(t, err)
+		require.False(t, cancelled, "a timer being handled has already fired")
+
+		return errors.New("failed")
+	})
+
+	timer := scheduleAndClaim(t, "test_cancel_in_flight", "1")
+	fire(zap.NewNop(), timer)
+
+	// A cancelled timer is not retried
+	requireTimerGone(t, server, "test_cancel_in_flight:1")
+}
+
+func setupSchedulerTest(t *testing.T) *miniredis.Miniredis {
+	server := miniredis.RunT(t)
+	redis.Client = goredis.NewClient(&goredis.Options{Addr: server.Addr()})
+
+	config.Conf.Timers.MaxAttempts = 5
+	config.Conf.Timers.RetryBackoff = time.Second * 30
+
+	return server
+}
+
+func scheduleAndClaim(t *testing.T, kind, id string) redis.Timer {
+	ctx := context.Background()
+	require.NoError(t, Schedule(ctx, kind, id, time.Now(), "payload"))
+
+	timers, err := redis.ClaimDueTimers(ctx, time.Now(), 10, leaseTimeout)
+	require.NoError(t, err)
+	require.Len(t, timers, 1)
+
+	return timers[0]
+}
+
+func requireTimerGone(t *testing.T, server *miniredis.Miniredis, member string) {
+	for _, key := range []string{"tickets:timers", "tickets:timers:inflight"} {
+		if server.Exists(key) {
+			members, err := server.ZMembers(key)
+			require.NoError(t, err)
+			require.NotContains(t, members, member, key)
+		}
+	}
+
+	for _, key := range []string{"tickets:timers:data", "tickets:timers:attempts"} {
+		if server.Exists(key) {
+			fields, err := server.HKeys(key)
+			require.NoError(t, err)
+			require.NotContains(t, fields, member, key)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type (
+	AutoAssignPolicies struct {
+		*pgxpool.Pool
+	}
+
+	AutoAssignStrategy string
+
+	// AutoAssignPolicy configures automatic assignment for a panel. A MaxOpenClaims of 0 means no limit.
+	AutoAssignPolicy struct {
+		Strategy      AutoAssignStrategy
+		MaxOpenClaims int
+	}
+)
+
+const (
+	AutoAssignRoundRobin  AutoAssignStrategy = "round_robin"
+	AutoAssignLeastLoaded AutoAssignStrategy = "least_loaded"
+	AutoAssignRandom      AutoAssignStrategy = "random"
+)
+
+var AutoAssignStrategies = []AutoAssignStrategy{AutoAssignRoundRobin, AutoAssignLeastLoaded, AutoAssignRandom}
+
+func (s AutoAssignStrategy) IsValid() bool {
+	for _, strategy := range AutoAssignStrategies {
+		if s == strategy {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newAutoAssignPolicies(db *pgxpool.Pool) *AutoAssignPolicies {
+	return &AutoAssignPolicies{
+		db,
+	}
+}
+
+func (a *AutoAssignPolicies) Get(ctx context.Context, guildId uint64, panelId int) (AutoAssignPolicy, bool, error) {
+	query := `SELECT "strategy", "max_open_claims" FROM auto_assign_policies WHERE "guild_id" = $1 AND "panel_id" = $2;`
+
+	var policy AutoAssignPolicy
+	if err := a.QueryRow(ctx, query, guildId, panelId).Scan(&policy.Strategy, &policy.MaxOpenClaims); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return AutoAssignPolicy{}, false, nil
+		}
+
+		return AutoAssignPolicy{}, false, err
+	}
+
+	return policy, true, nil
+}
+
+func (a *AutoAssignPolicies) Set(ctx context.Context, guildId uint64, panelId int, policy AutoAssignPolicy) (err error) {
+	query := `
+INSERT INTO auto_assign_policies("panel_id", "guild_id", "strategy", "max_open_claims")
+VALUES($1, $2, $3, $4)
+ON CONFLICT("panel_id") DO UPDATE SET "strategy" = $3, "max_open_claims" = $4;`
+
+	_, err = a.Exec(ctx, query, panelId, guildId, policy.Strategy, policy.MaxOpenClaims)
+	return
+}
+
+func (a *AutoAssignPolicies) Delete(ctx context.Context, guildId uint64, panelId int) (err error) {
+	query := `DELETE FROM auto_assign_policies WHERE "guild_id" = $1 AND "panel_id" = $2;`
+	_, err = a.Exec(ctx, query, guildId, panelId)
+	return
+}
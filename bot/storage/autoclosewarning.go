@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type (
+	AutoCloseWarnings struct {
+		*pgxpool.Pool
+	}
+
+	// AutoCloseWarning configures the warning posted in a ticket before it is automatically closed. The ticket is
+	// closed Period after the warning is sent, unless someone replies or presses the keep open button first.
+	AutoCloseWarning struct {
+		Period time.Duration
+		Dm     bool
+	}
+)
+
+func newAutoCloseWarnings(db *pgxpool.Pool) *AutoCloseWarnings {
+	return &AutoCloseWarnings{
+		db,
+	}
+}
+
+func (a *AutoCloseWarnings) Get(ctx context.Context, guildId uint64) (AutoCloseWarning, bool, error) {
+	query := `SELECT "period", "dm" FROM auto_close_warnings WHERE "guild_id" = $1;`
+
+	var warning AutoCloseWarning
+	if err := a.QueryRow(ctx, query, guildId).Scan(&warning.Period, &warning.Dm); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return AutoCloseWarning{}, false, nil
+		}
+
+		return AutoCloseWarning{}, false, err
+	}
+
+	return warning, true, nil
+}
+
+func (a *AutoCloseWarnings) Set(ctx context.Context, guildId uint64, warning AutoCloseWarning) (err error) {
+	query := `
+INSERT INTO auto_close_warnings("guild_id", "period", "dm")
+VALUES($1, $2, $3)
+ON CONFLICT("guild_id") DO UPDATE SET "period" = $2, "dm" = $3;`
+
+	_, err = a.Exec(ctx, query, guildId, warning.Period, warning.Dm)
+	return
+}
+
+func (a *AutoCloseWarnings) Delete(ctx context.Context, guildId uint64) (bool, error) {
+	query := `DELETE FROM auto_close_warnings WHERE "guild_id" = $1;`
+
+	tag, err := a.Exec(ctx, query, guildId)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
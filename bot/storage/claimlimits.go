@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type (
+	ClaimLimitsTable struct {
+		*pgxpool.Pool
+	}
+
+	// ClaimLimits holds the maximum number of open tickets a staff member may have claimed at once, guild-wide and
+	// for members of specific support teams. A limit of 0 means no limit.
+	ClaimLimits struct {
+		Guild int
+		Teams map[int]int
+	}
+)
+
+func newClaimLimitsTable(db *pgxpool.Pool) *ClaimLimitsTable {
+	return &ClaimLimitsTable{
+		db,
+	}
+}
+
+func (c *ClaimLimitsTable) Get(ctx context.Context, guildId uint64) (ClaimLimits, error) {
+	query := `SELECT "team_id", "claim_limit" FROM claim_limits WHERE "guild_id" = $1;`
+
+	rows, err := c.Query(ctx, query, guildId)
+	if err != nil {
+		return ClaimLimits{}, err
+	}
+	defer rows.Close()
+
+	limits := ClaimLimits{
+		Teams: make(map[int]int),
+	}
+
+	for rows.Next() {
+		var teamId, limit int
+		if err := rows.Scan(&teamId, &limit); err != nil {
+			return ClaimLimits{}, err
+		}
+
+		if teamId == 0 {
+			limits.Guild = limit
+		} else {
+			limits.Teams[teamId] = limit
+		}
+	}
+
+	return limits, rows.Err()
+}
+
+// Set sets the guild-wide limit if teamId is nil, or otherwise the limit for the team's members
+func (c *ClaimLimitsTable) Set(ctx context.Context, guildId uint64, teamId *int, limit int) (err error) {
+	query := `
+INSERT INTO claim_limits("guild_id", "team_id", "claim_limit")
+VALUES($1, $2, $3)
+ON CONFLICT("guild_id", "team_id") DO UPDATE SET "claim_limit" = $3;`
+
+	_, err = c.Exec(ctx, query, guildId, claimLimitTeamId(teamId), limit)
+	return
+}
+
+// Delete removes the guild-wide limit if teamId is nil, or otherwise the limit for the team's members
+func (c *ClaimLimitsTable) Delete(ctx context.Context, guildId uint64, teamId *int) (bool, error) {
+	query := `DELETE FROM claim_limits WHERE "guild_id" = $1 AND "team_id" = $2;`
+
+	tag, err := c.Exec(ctx, query, guildId, claimLimitTeamId(teamId))
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func claimLimitTeamId(teamId *int) int {
+	if teamId == nil {
+		return 0
+	}
+
+	return *teamId
+}
@@ -0,0 +1,52 @@
+// Package storage holds the Postgres tables for the worker's own settings and per-ticket state, following the
+// conventions of the database module. Ephemeral state such as timers and form sessions is kept in Redis instead.
+//
+// The worker does not create the tables itself: the migrations in the migrations directory must be applied when
+// deploying, after the database module's schema.
+package storage
+
+import (
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type Database struct {
+	SlaPolicies        *SlaPolicies
+	SlaStats           *SlaStatsTable
+	AutoAssignPolicies *AutoAssignPolicies
+	ClaimLimits        *ClaimLimitsTable
+	EscalationPolicies *EscalationPolicies
+	AutoCloseWarnings  *AutoCloseWarnings
+	SnoozeSettings     *SnoozeSettingsTable
+	TicketSnoozes      *TicketSnoozes
+	TicketEscalations  *TicketEscalations
+	PrioritySettings   *PrioritySettingsTable
+	TicketPriorities   *TicketPriorities
+	FormValidation     *FormValidationRules
+	FormFlows          *FormFlows
+	RoutingSettings    *RoutingSettingsTable
+	TicketRouting      *TicketRoutings
+	TicketFormAnswers  *TicketFormAnswersTable
+	FirstResponseTime  *FirstResponseTimes
+}
+
+func NewDatabase(pool *pgxpool.Pool) *Database {
+	return &Database{
+		SlaPolicies:        newSlaPolicies(pool),
+		SlaStats:           newSlaStatsTable(pool),
+		AutoAssignPolicies: newAutoAssignPolicies(pool),
+		ClaimLimits:        newClaimLimitsTable(pool),
+		EscalationPolicies: newEscalationPolicies(pool),
+		AutoCloseWarnings:  newAutoCloseWarnings(pool),
+		SnoozeSettings:     newSnoozeSettingsTable(pool),
+		TicketSnoozes:      newTicketSnoozes(pool),
+		TicketEscalations:  newTicketEscalations(pool),
+		PrioritySettings:   newPrioritySettingsTable(pool),
+		TicketPriorities:   newTicketPriorities(pool),
+		FormValidation:     newFormValidationRules(pool),
+		FormFlows:          newFormFlows(pool),
+		RoutingSettings:    newRoutingSettingsTable(pool),
+		TicketRouting:      newTicketRoutings(pool),
+		TicketFormAnswers:  newTicketFormAnswersTable(pool),
+		FirstResponseTime:  newFirstResponseTimes(pool),
+	}
+}
@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type (
+	EscalationPolicies struct {
+		*pgxpool.Pool
+	}
+
+	EscalationTarget string
+
+	// EscalationPolicy configures who is pinged when a ticket has no staff response after After, and how often
+	// the ping repeats. A MaxPings of 0 repeats the ping until staff respond.
+	EscalationPolicy struct {
+		After    time.Duration
+		Interval time.Duration
+		MaxPings int
+		Target   EscalationTarget
+		TeamId   int
+		UserId   uint64
+	}
+)
+
+const (
+	EscalationTargetOnCall EscalationTarget = "on_call"
+	EscalationTargetTeam   EscalationTarget = "team"
+	EscalationTargetAdmins EscalationTarget = "admins"
+	EscalationTargetUser   EscalationTarget = "user"
+)
+
+var EscalationTargets = []EscalationTarget{EscalationTargetOnCall, EscalationTargetTeam, EscalationTargetAdmins, EscalationTargetUser}
+
+func (t EscalationTarget) IsValid() bool {
+	for _, target := range EscalationTargets {
+		if t == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newEscalationPolicies(db *pgxpool.Pool) *EscalationPolicies {
+	return &EscalationPolicies{
+		db,
+	}
+}
+
+func (e *EscalationPolicies) Get(ctx context.Context, guildId uint64) (EscalationPolicy, bool, error) {
+	query := `SELECT "after", "ping_interval", "max_pings", "target", "team_id", "user_id" FROM escalation_policies WHERE "guild_id" = $1;`
+
+	var policy EscalationPolicy
+	if err := e.QueryRow(ctx, query, guildId).Scan(&policy.After, &policy.Interval, &policy.MaxPings, &policy.Target, &policy.TeamId, &policy.UserId); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return EscalationPolicy{}, false, nil
+		}
+
+		return EscalationPolicy{}, false, err
+	}
+
+	return policy, true, nil
+}
+
+func (e *EscalationPolicies) Set(ctx context.Context, guildId uint64, policy EscalationPolicy) (err error) {
+	query := `
+INSERT INTO escalation_policies("guild_id", "after", "ping_interval", "max_pings", "target", "team_id", "user_id")
+VALUES($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT("guild_id") DO UPDATE SET
+	"after" = $2,
+	"ping_interval" = $3,
+	"max_pings" = $4,
+	"target" = $5,
+	"team_id" = $6,
+	"user_id" = $7;`
+
+	_, err = e.Exec(ctx, query, guildId, policy.After, policy.Interval, policy.MaxPings, policy.Target, policy.TeamId, policy.UserId)
+	return
+}
+
+func (e *EscalationPolicies) Delete(ctx context.Context, guildId uint64) (bool, error) {
+	query := `DELETE FROM escalation_policies WHERE "guild_id" = $1;`
+
+	tag, err := e.Exec(ctx, query, guildId)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// FirstResponseTimes reads the first_response_time table, which is owned and written by the database module
+type FirstResponseTimes struct {
+	*pgxpool.Pool
+}
+
+func newFirstResponseTimes(db *pgxpool.Pool) *FirstResponseTimes {
+	return &FirstResponseTimes{
+		db,
+	}
+}
+
+// Get returns how long it took staff to first respond to the ticket, or nil if they have not responded yet
+func (f *FirstResponseTimes) Get(ctx context.Context, guildId uint64, ticketId int) (responseTime *time.Duration, e error) {
+	query := `SELECT "response_time" FROM first_response_time WHERE "guild_id" = $1 AND "ticket_id" = $2;`
+	if err := f.QueryRow(ctx, query, guildId, ticketId).Scan(&responseTime); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		e = err
+	}
+
+	return
+}
@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type (
+	TicketFormAnswersTable struct {
+		*pgxpool.Pool
+	}
+
+	// TicketFormAnswer is the answer given to a form input when the ticket was opened. The label and custom id are
+	// copied from the input, so that placeholders keep working if the form is edited afterwards.
+	TicketFormAnswer struct {
+		Label    string `json:"label"`
+		CustomId string `json:"custom_id"`
+		Answer   string `json:"answer"`
+	}
+
+	// TicketFormAnswers are ordered by the step of the form they were given in, and then by the inputs' positions
+	TicketFormAnswers []TicketFormAnswer
+)
+
+// Get returns the answer to the input with the given custom id, or failing that, the given label, ignoring case
+func (a TicketFormAnswers) Get(key string) (string, bool) {
+	for _, answer := range a {
+		if answer.CustomId != "" && answer.CustomId == key {
+			return answer.Answer, true
+		}
+	}
+
+	for _, answer := range a {
+		if strings.EqualFold(answer.Label, strings.TrimSpace(key)) {
+			return answer.Answer, true
+		}
+	}
+
+	return "", false
+}
+
+func newTicketFormAnswersTable(db *pgxpool.Pool) *TicketFormAnswersTable {
+	return &TicketFormAnswersTable{
+		db,
+	}
+}
+
+// Get returns the answers given when the ticket was opened, which are nil if it was not opened with a form
+func (t *TicketFormAnswersTable) Get(ctx context.Context, guildId uint64, ticketId int) (TicketFormAnswers, error) {
+	query := `SELECT "answers" FROM ticket_form_answers WHERE "guild_id" = $1 AND "ticket_id" = $2;`
+
+	var answers TicketFormAnswers
+	if err := t.QueryRow(ctx, query, guildId, ticketId).Scan(&answers); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return answers, nil
+}
+
+func (t *TicketFormAnswersTable) Set(ctx context.Context, guildId uint64, ticketId int, answers TicketFormAnswers) (err error) {
+	query := `
+INSERT INTO ticket_form_answers("guild_id", "ticket_id", "answers")
+VALUES($1, $2, $3)
+ON CONFLICT("guild_id", "ticket_id") DO UPDATE SET "answers" = $3;`
+
+	_, err = t.Exec(ctx, query, guildId, ticketId, answers)
+	return
+}
@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type (
+	FormFlows struct {
+		*pgxpool.Pool
+	}
+
+	// FormBranch sends users whose answer to InputId is one of Values on to NextFormId
+	FormBranch struct {
+		InputId    int      `json:"input_id"`
+		Values     []string `json:"values"`
+		NextFormId int      `json:"next_form_id"`
+	}
+
+	// FormFlow decides which form follows a step of a multi-step form. Branches are checked in order, and if none
+	// match, DefaultNextFormId is shown. A next form ID of 0 ends the form.
+	FormFlow struct {
+		Branches          []FormBranch
+		DefaultNextFormId int
+	}
+)
+
+func newFormFlows(db *pgxpool.Pool) *FormFlows {
+	return &FormFlows{
+		db,
+	}
+}
+
+func (f *FormFlows) Get(ctx context.Context, guildId uint64, formId int) (FormFlow, bool, error) {
+	query := `SELECT "branches", "default_next_form_id" FROM form_flows WHERE "guild_id" = $1 AND "form_id" = $2;`
+
+	var flow FormFlow
+	if err := f.QueryRow(ctx, query, guildId, formId).Scan(&flow.Branches, &flow.DefaultNextFormId); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return FormFlow{}, false, nil
+		}
+
+		return FormFlow{}, false, err
+	}
+
+	return flow, true, nil
+}
+
+func (f *FormFlows) Set(ctx context.Context, guildId uint64, formId int, flow FormFlow) (err error) {
+	query := `
+INSERT INTO form_flows("form_id", "guild_id", "branches", "default_next_form_id")
+VALUES($1, $2, $3, $4)
+ON CONFLICT("form_id") DO UPDATE SET "branches" = $3, "default_next_form_id" = $4;`
+
+	_, err = f.Exec(ctx, query, formId, guildId, flow.Branches, flow.DefaultNextFormId)
+	return
+}
+
+// Delete removes the form's branches, returning false if it did not have any
+func (f *FormFlows) Delete(ctx context.Context, guildId uint64, formId int) (bool, error) {
+	query := `DELETE FROM form_flows WHERE "guild_id" = $1 AND "form_id" = $2;`
+
+	tag, err := f.Exec(ctx, query, guildId, formId)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
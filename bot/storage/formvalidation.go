@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type (
+	FormValidationRules struct {
+		*pgxpool.Pool
+	}
+
+	FormValidationType string
+
+	// FormValidationRule restricts the answers accepted for a form input. The length limits apply to every type,
+	// while Min and Max only apply to integer and decimal answers. ErrorMessage replaces the default error shown
+	// when a regex rule does not match.
+	FormValidationRule struct {
+		Type         FormValidationType
+		Pattern      string
+		ErrorMessage string
+		MinLength    *int
+		MaxLength    *int
+		Min          *float64
+		Max          *float64
+	}
+)
+
+const (
+	FormValidationText      FormValidationType = "text"
+	FormValidationRegex     FormValidationType = "regex"
+	FormValidationInteger   FormValidationType = "integer"
+	FormValidationDecimal   FormValidationType = "decimal"
+	FormValidationEmail     FormValidationType = "email"
+	FormValidationUrl       FormValidationType = "url"
+	FormValidationSnowflake FormValidationType = "snowflake"
+)
+
+var FormValidationTypes = []FormValidationType{
+	FormValidationText,
+	FormValidationRegex,
+	FormValidationInteger,
+	FormValidationDecimal,
+	FormValidationEmail,
+	FormValidationUrl,
+	FormValidationSnowflake,
+}
+
+func (t FormValidationType) IsValid() bool {
+	for _, validationType := range FormValidationTypes {
+		if t == validationType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newFormValidationRules(db *pgxpool.Pool) *FormValidationRules {
+	return &FormValidationRules{
+		db,
+	}
+}
+
+// GetAll returns the guild's validation rules, by form input ID
+func (f *FormValidationRules) GetAll(ctx context.Context, guildId uint64) (map[int]FormValidationRule, error) {
+	query := `
+SELECT "form_input_id", "type", "pattern", "error_message", "min_length", "max_length", "min", "max"
+FROM form_validation_rules
+WHERE "guild_id" = $1;`
+
+	rows, err := f.Query(ctx, query, guildId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make(map[int]FormValidationRule)
+	for rows.Next() {
+		var inputId int
+		var rule FormValidationRule
+		if err := rows.Scan(&inputId, &rule.Type, &rule.Pattern, &rule.ErrorMessage, &rule.MinLength, &rule.MaxLength, &rule.Min, &rule.Max); err != nil {
+			return nil, err
+		}
+
+		rules[inputId] = rule
+	}
+
+	return rules, rows.Err()
+}
+
+func (f *FormValidationRules) Set(ctx context.Context, guildId uint64, inputId int, rule FormValidationRule) (err error) {
+	query := `
+INSERT INTO form_validation_rules("form_input_id", "guild_id", "type", "pattern", "error_message", "min_length", "max_length", "min", "max")
+VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT("form_input_id") DO UPDATE SET
+	"type" = $3,
+	"pattern" = $4,
+	"error_message" = $5,
+	"min_length" = $6,
+	"max_length" = $7,
+	"min" = $8,
+	"max" = $9;`
+
+	_, err = f.Exec(ctx, query, inputId, guildId, rule.Type, rule.Pattern, rule.ErrorMessage, rule.MinLength, rule.MaxLength, rule.Min, rule.Max)
+	return
+}
+
+// Delete removes the input's rule, returning false if it did not have one
+func (f *FormValidationRules) Delete(ctx context.Context, guildId uint64, inputId int) (bool, error) {
+	query := `DELETE FROM form_validation_rules WHERE "guild_id" = $1 AND "form_input_id" = $2;`
+
+	tag, err := f.Exec(ctx, query, guildId, inputId)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
@@ -0,0 +1,169 @@
+-- Tables for the worker's own settings and per-ticket state. They reference the tickets, panels, forms and form_input
+-- tables, so must be applied after the database module's schema.
+
+CREATE TABLE IF NOT EXISTS sla_policies(
+	"panel_id" int NOT NULL,
+	"guild_id" int8 NOT NULL,
+	"first_response" interval NOT NULL,
+	"resolution" interval NOT NULL,
+	"warn_before" interval NOT NULL,
+	FOREIGN KEY("panel_id") REFERENCES panels("panel_id") ON DELETE CASCADE ON UPDATE CASCADE,
+	PRIMARY KEY("panel_id")
+);
+CREATE INDEX IF NOT EXISTS sla_policies_guild_id ON sla_policies("guild_id");
+
+-- The guild's totals are stored with a user_id of 0. sla_outcomes records the ticket's targets that have already
+-- been counted, so that each is only counted once.
+CREATE TABLE IF NOT EXISTS sla_stats(
+	"guild_id" int8 NOT NULL,
+	"user_id" int8 NOT NULL,
+	"target" varchar(32) NOT NULL,
+	"met" int8 NOT NULL DEFAULT 0,
+	"breached" int8 NOT NULL DEFAULT 0,
+	PRIMARY KEY("guild_id", "user_id", "target")
+);
+CREATE TABLE IF NOT EXISTS sla_outcomes(
+	"guild_id" int8 NOT NULL,
+	"ticket_id" int4 NOT NULL,
+	"target" varchar(32) NOT NULL,
+	"breached" bool NOT NULL,
+	FOREIGN KEY("guild_id", "ticket_id") REFERENCES tickets("guild_id", "id") ON DELETE CASCADE,
+	PRIMARY KEY("guild_id", "ticket_id", "target")
+);
+
+CREATE TABLE IF NOT EXISTS auto_assign_policies(
+	"panel_id" int NOT NULL,
+	"guild_id" int8 NOT NULL,
+	"strategy" varchar(32) NOT NULL,
+	"max_open_claims" int NOT NULL,
+	FOREIGN KEY("panel_id") REFERENCES panels("panel_id") ON DELETE CASCADE ON UPDATE CASCADE,
+	PRIMARY KEY("panel_id")
+);
+CREATE INDEX IF NOT EXISTS auto_assign_policies_guild_id ON auto_assign_policies("guild_id");
+
+-- The guild-wide limit is stored with a team_id of 0
+CREATE TABLE IF NOT EXISTS claim_limits(
+	"guild_id" int8 NOT NULL,
+	"team_id" int NOT NULL,
+	"claim_limit" int NOT NULL,
+	PRIMARY KEY("guild_id", "team_id")
+);
+
+CREATE TABLE IF NOT EXISTS escalation_policies(
+	"guild_id" int8 NOT NULL,
+	"after" interval NOT NULL,
+	"ping_interval" interval NOT NULL,
+	"max_pings" int NOT NULL,
+	"target" varchar(32) NOT NULL,
+	"team_id" int NOT NULL DEFAULT 0,
+	"user_id" int8 NOT NULL DEFAULT 0,
+	PRIMARY KEY("guild_id")
+);
+
+CREATE TABLE IF NOT EXISTS auto_close_warnings(
+	"guild_id" int8 NOT NULL,
+	"period" interval NOT NULL,
+	"dm" bool NOT NULL,
+	PRIMARY KEY("guild_id")
+);
+
+CREATE TABLE IF NOT EXISTS snooze_settings(
+	"guild_id" int8 NOT NULL,
+	"category_id" int8 NOT NULL,
+	"show_button" bool NOT NULL,
+	PRIMARY KEY("guild_id")
+);
+
+CREATE TABLE IF NOT EXISTS ticket_snoozes(
+	"guild_id" int8 NOT NULL,
+	"ticket_id" int4 NOT NULL,
+	"until" timestamptz NOT NULL,
+	"snoozed_by" int8 NOT NULL,
+	"previous_category_id" int8,
+	"sla_remaining" jsonb,
+	FOREIGN KEY("guild_id", "ticket_id") REFERENCES tickets("guild_id", "id") ON DELETE CASCADE,
+	PRIMARY KEY("guild_id", "ticket_id")
+);
+
+CREATE TABLE IF NOT EXISTS ticket_escalations(
+	"guild_id" int8 NOT NULL,
+	"ticket_id" int4 NOT NULL,
+	"level" int NOT NULL,
+	"team_id" int NOT NULL,
+	"history" jsonb NOT NULL,
+	FOREIGN KEY("guild_id", "ticket_id") REFERENCES tickets("guild_id", "id") ON DELETE CASCADE,
+	PRIMARY KEY("guild_id", "ticket_id")
+);
+CREATE TABLE IF NOT EXISTS escalation_stats(
+	"guild_id" int8 NOT NULL,
+	"tickets" int8 NOT NULL DEFAULT 0,
+	"escalations" int8 NOT NULL DEFAULT 0,
+	PRIMARY KEY("guild_id")
+);
+
+CREATE TABLE IF NOT EXISTS priority_settings(
+	"guild_id" int8 NOT NULL,
+	"panel_defaults" jsonb,
+	"form_question" text NOT NULL,
+	"urgent_role_id" int8 NOT NULL,
+	"reorder" bool NOT NULL,
+	PRIMARY KEY("guild_id")
+);
+
+CREATE TABLE IF NOT EXISTS ticket_priorities(
+	"guild_id" int8 NOT NULL,
+	"ticket_id" int4 NOT NULL,
+	"priority" varchar(16) NOT NULL,
+	FOREIGN KEY("guild_id", "ticket_id") REFERENCES tickets("guild_id", "id") ON DELETE CASCADE,
+	PRIMARY KEY("guild_id", "ticket_id")
+);
+
+CREATE TABLE IF NOT EXISTS form_validation_rules(
+	"form_input_id" int NOT NULL,
+	"guild_id" int8 NOT NULL,
+	"type" varchar(16) NOT NULL,
+	"pattern" text NOT NULL,
+	"error_message" text NOT NULL,
+	"min_length" int,
+	"max_length" int,
+	"min" float8,
+	"max" float8,
+	FOREIGN KEY("form_input_id") REFERENCES form_input("id") ON DELETE CASCADE ON UPDATE CASCADE,
+	PRIMARY KEY("form_input_id")
+);
+CREATE INDEX IF NOT EXISTS form_validation_rules_guild_id ON form_validation_rules("guild_id");
+
+CREATE TABLE IF NOT EXISTS form_flows(
+	"form_id" int NOT NULL,
+	"guild_id" int8 NOT NULL,
+	"branches" jsonb,
+	"default_next_form_id" int NOT NULL,
+	FOREIGN KEY("form_id") REFERENCES forms("form_id") ON DELETE CASCADE ON UPDATE CASCADE,
+	PRIMARY KEY("form_id")
+);
+
+CREATE TABLE IF NOT EXISTS routing_settings(
+	"guild_id" int8 NOT NULL,
+	"rules" jsonb,
+	"dry_run" bool NOT NULL,
+	"dry_run_channel_id" int8 NOT NULL,
+	PRIMARY KEY("guild_id")
+);
+
+CREATE TABLE IF NOT EXISTS ticket_routing(
+	"guild_id" int8 NOT NULL,
+	"ticket_id" int4 NOT NULL,
+	"rule" text NOT NULL,
+	"team_ids" jsonb,
+	"naming_scheme" text NOT NULL,
+	FOREIGN KEY("guild_id", "ticket_id") REFERENCES tickets("guild_id", "id") ON DELETE CASCADE,
+	PRIMARY KEY("guild_id", "ticket_id")
+);
+
+CREATE TABLE IF NOT EXISTS ticket_form_answers(
+	"guild_id" int8 NOT NULL,
+	"ticket_id" int4 NOT NULL,
+	"answers" jsonb NOT NULL,
+	FOREIGN KEY("guild_id", "ticket_id") REFERENCES tickets("guild_id", "id") ON DELETE CASCADE,
+	PRIMARY KEY("guild_id", "ticket_id")
+);
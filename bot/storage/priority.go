@@ -0,0 +1,145 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type (
+	TicketPriority string
+
+	PrioritySettingsTable struct {
+		*pgxpool.Pool
+	}
+
+	// PrioritySettings configures ticket priorities for a guild. FormQuestion is the label of the form input whose
+	// answer sets the priority of new tickets, and UrgentRoleId is pinged instead of the panel's mentions when an
+	// urgent ticket is opened.
+	PrioritySettings struct {
+		PanelDefaults map[int]TicketPriority
+		FormQuestion  string
+		UrgentRoleId  uint64
+		Reorder       bool
+	}
+
+	TicketPriorities struct {
+		*pgxpool.Pool
+	}
+)
+
+const (
+	PriorityLow    TicketPriority = "low"
+	PriorityNormal TicketPriority = "normal"
+	PriorityHigh   TicketPriority = "high"
+	PriorityUrgent TicketPriority = "urgent"
+)
+
+// Priorities is ordered from the lowest priority to the highest
+var Priorities = []TicketPriority{PriorityLow, PriorityNormal, PriorityHigh, PriorityUrgent}
+
+func (p TicketPriority) IsValid() bool {
+	return p.Rank() >= 0
+}
+
+// Rank returns the priority's position in Priorities, or -1 if it is not valid
+func (p TicketPriority) Rank() int {
+	for i, priority := range Priorities {
+		if p == priority {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func newPrioritySettingsTable(db *pgxpool.Pool) *PrioritySettingsTable {
+	return &PrioritySettingsTable{
+		db,
+	}
+}
+
+func (p *PrioritySettingsTable) Get(ctx context.Context, guildId uint64) (settings PrioritySettings, e error) {
+	query := `SELECT "panel_defaults", "form_question", "urgent_role_id", "reorder" FROM priority_settings WHERE "guild_id" = $1;`
+	if err := p.QueryRow(ctx, query, guildId).Scan(&settings.PanelDefaults, &settings.FormQuestion, &settings.UrgentRoleId, &settings.Reorder); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		e = err
+	}
+
+	return
+}
+
+func (p *PrioritySettingsTable) Set(ctx context.Context, guildId uint64, settings PrioritySettings) (err error) {
+	query := `
+INSERT INTO priority_settings("guild_id", "panel_defaults", "form_question", "urgent_role_id", "reorder")
+VALUES($1, $2, $3, $4, $5)
+ON CONFLICT("guild_id") DO UPDATE SET
+	"panel_defaults" = $2,
+	"form_question" = $3,
+	"urgent_role_id" = $4,
+	"reorder" = $5;`
+
+	_, err = p.Exec(ctx, query, guildId, settings.PanelDefaults, settings.FormQuestion, settings.UrgentRoleId, settings.Reorder)
+	return
+}
+
+func newTicketPriorities(db *pgxpool.Pool) *TicketPriorities {
+	return &TicketPriorities{
+		db,
+	}
+}
+
+// Get returns the ticket's priority, which is normal if it has not been set
+func (t *TicketPriorities) Get(ctx context.Context, guildId uint64, ticketId int) (TicketPriority, error) {
+	query := `SELECT "priority" FROM ticket_priorities WHERE "guild_id" = $1 AND "ticket_id" = $2;`
+
+	var priority TicketPriority
+	if err := t.QueryRow(ctx, query, guildId, ticketId).Scan(&priority); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return PriorityNormal, nil
+		}
+
+		return "", err
+	}
+
+	return priority, nil
+}
+
+// GetOpen returns the priorities of the guild's open tickets that have one set, by ticket ID
+func (t *TicketPriorities) GetOpen(ctx context.Context, guildId uint64) (map[int]TicketPriority, error) {
+	query := `
+SELECT ticket_priorities."ticket_id", ticket_priorities."priority"
+FROM ticket_priorities
+INNER JOIN tickets ON tickets."guild_id" = ticket_priorities."guild_id" AND tickets."id" = ticket_priorities."ticket_id"
+WHERE ticket_priorities."guild_id" = $1 AND tickets."open" = true;`
+
+	rows, err := t.Query(ctx, query, guildId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	priorities := make(map[int]TicketPriority)
+	for rows.Next() {
+		var ticketId int
+		var priority TicketPriority
+		if err := rows.Scan(&ticketId, &priority); err != nil {
+			return nil, err
+		}
+
+		priorities[ticketId] = priority
+	}
+
+	return priorities, rows.Err()
+}
+
+func (t *TicketPriorities) Set(ctx context.Context, guildId uint64, ticketId int, priority TicketPriority) (err error) {
+	query := `
+INSERT INTO ticket_priorities("guild_id", "ticket_id", "priority")
+VALUES($1, $2, $3)
+ON CONFLICT("guild_id", "ticket_id") DO UPDATE SET "priority" = $3;`
+
+	_, err = t.Exec(ctx, query, guildId, ticketId, priority)
+	return
+}
@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type (
+	RoutingSettingsTable struct {
+		*pgxpool.Pool
+	}
+
+	// RoutingSettings holds a guild's routing rules, which are checked in order when a ticket is opened, the first
+	// matching rule being applied. In dry run mode, rules are never applied, and the rule that would have been is
+	// reported in DryRunChannelId instead.
+	RoutingSettings struct {
+		Rules           []RoutingRule
+		DryRun          bool
+		DryRunChannelId uint64
+	}
+
+	// RoutingRule matches tickets meeting every one of its conditions that are set, and overrides where they are sent
+	RoutingRule struct {
+		Name string `json:"name"`
+
+		// Conditions
+		PanelId   int               `json:"panel_id,omitempty"`
+		Question  string            `json:"question,omitempty"`
+		Answers   []string          `json:"answers,omitempty"`
+		RoleId    uint64            `json:"role_id,omitempty"`
+		Locale    string            `json:"locale,omitempty"`
+		TimeRange *RoutingTimeRange `json:"time_range,omitempty"`
+
+		// Overrides
+		CategoryId     uint64   `json:"category_id,omitempty"`
+		TeamIds        []int    `json:"team_ids,omitempty"`
+		MentionRoleIds []uint64 `json:"mention_role_ids,omitempty"`
+		NamingScheme   string   `json:"naming_scheme,omitempty"`
+		LabelIds       []int    `json:"label_ids,omitempty"`
+	}
+
+	// RoutingTimeRange is the time of day during which a rule applies, in minutes after midnight. If Start is after
+	// End, the range runs over midnight.
+	RoutingTimeRange struct {
+		Start    int    `json:"start"`
+		End      int    `json:"end"`
+		Timezone string `json:"timezone"`
+	}
+
+	TicketRoutings struct {
+		*pgxpool.Pool
+	}
+
+	// TicketRouting records the rule that a ticket was routed by, and the overrides that still apply after it has
+	// been opened
+	TicketRouting struct {
+		Rule         string
+		TeamIds      []int
+		NamingScheme string
+	}
+)
+
+func newRoutingSettingsTable(db *pgxpool.Pool) *RoutingSettingsTable {
+	return &RoutingSettingsTable{
+		db,
+	}
+}
+
+func (r *RoutingSettingsTable) Get(ctx context.Context, guildId uint64) (settings RoutingSettings, e error) {
+	query := `SELECT "rules", "dry_run", "dry_run_channel_id" FROM routing_settings WHERE "guild_id" = $1;`
+	if err := r.QueryRow(ctx, query, guildId).Scan(&settings.Rules, &settings.DryRun, &settings.DryRunChannelId); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		e = err
+	}
+
+	return
+}
+
+func (r *RoutingSettingsTable) Set(ctx context.Context, guildId uint64, settings RoutingSettings) (err error) {
+	query := `
+INSERT INTO routing_settings("guild_id", "rules", "dry_run", "dry_run_channel_id")
+VALUES($1, $2, $3, $4)
+ON CONFLICT("guild_id") DO UPDATE SET
+	"rules" = $2,
+	"dry_run" = $3,
+	"dry_run_channel_id" = $4;`
+
+	_, err = r.Exec(ctx, query, guildId, settings.Rules, settings.DryRun, settings.DryRunChannelId)
+	return
+}
+
+func newTicketRoutings(db *pgxpool.Pool) *TicketRoutings {
+	return &TicketRoutings{
+		db,
+	}
+}
+
+func (t *TicketRoutings) Get(ctx context.Context, guildId uint64, ticketId int) (TicketRouting, bool, error) {
+	query := `SELECT "rule", "team_ids", "naming_scheme" FROM ticket_routing WHERE "guild_id" = $1 AND "ticket_id" = $2;`
+
+	var routing TicketRouting
+	if err := t.QueryRow(ctx, query, guildId, ticketId).Scan(&routing.Rule, &routing.TeamIds, &routing.NamingScheme); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return TicketRouting{}, false, nil
+		}
+
+		return TicketRouting{}, false, err
+	}
+
+	return routing, true, nil
+}
+
+func (t *TicketRoutings) Set(ctx context.Context, guildId uint64, ticketId int, routing TicketRouting) (err error) {
+	query := `
+INSERT INTO ticket_routing("guild_id", "ticket_id", "rule", "team_ids", "naming_scheme")
+VALUES($1, $2, $3, $4, $5)
+ON CONFLICT("guild_id", "ticket_id") DO UPDATE SET
+	"rule" = $3,
+	"team_ids" = $4,
+	"naming_scheme" = $5;`
+
+	_, err = t.Exec(ctx, query, guildId, ticketId, routing.Rule, routing.TeamIds, routing.NamingScheme)
+	return
+}
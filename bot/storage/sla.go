@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type (
+	SlaPolicies struct {
+		*pgxpool.Pool
+	}
+
+	// SlaPolicy holds a panel's targets. A zero target is not tracked.
+	SlaPolicy struct {
+		FirstResponse time.Duration
+		Resolution    time.Duration
+		WarnBefore    time.Duration
+	}
+)
+
+func newSlaPolicies(db *pgxpool.Pool) *SlaPolicies {
+	return &SlaPolicies{
+		db,
+	}
+}
+
+func (s *SlaPolicies) Get(ctx context.Context, guildId uint64, panelId int) (SlaPolicy, bool, error) {
+	query := `SELECT "first_response", "resolution", "warn_before" FROM sla_policies WHERE "guild_id" = $1 AND "panel_id" = $2;`
+
+	var policy SlaPolicy
+	if err := s.QueryRow(ctx, query, guildId, panelId).Scan(&policy.FirstResponse, &policy.Resolution, &policy.WarnBefore); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return SlaPolicy{}, false, nil
+		}
+
+		return SlaPolicy{}, false, err
+	}
+
+	return policy, true, nil
+}
+
+func (s *SlaPolicies) Set(ctx context.Context, guildId uint64, panelId int, policy SlaPolicy) (err error) {
+	query := `
+INSERT INTO sla_policies("panel_id", "guild_id", "first_response", "resolution", "warn_before")
+VALUES($1, $2, $3, $4, $5)
+ON CONFLICT("panel_id") DO UPDATE SET "first_response" = $3, "resolution" = $4, "warn_before" = $5;`
+
+	_, err = s.Exec(ctx, query, panelId, guildId, policy.FirstResponse, policy.Resolution, policy.WarnBefore)
+	return
+}
+
+func (s *SlaPolicies) Delete(ctx context.Context, guildId uint64, panelId int) (err error) {
+	query := `DELETE FROM sla_policies WHERE "guild_id" = $1 AND "panel_id" = $2;`
+	_, err = s.Exec(ctx, query, guildId, panelId)
+	return
+}
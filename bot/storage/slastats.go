@@ -0,0 +1,138 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type (
+	SlaStatsTable struct {
+		*pgxpool.Pool
+	}
+
+	SlaTarget string
+
+	SlaStats struct {
+		FirstResponseMet      int64
+		FirstResponseBreached int64
+		ResolutionMet         int64
+		ResolutionBreached    int64
+	}
+)
+
+const (
+	SlaTargetFirstResponse SlaTarget = "first_response"
+	SlaTargetResolution    SlaTarget = "resolution"
+)
+
+func newSlaStatsTable(db *pgxpool.Pool) *SlaStatsTable {
+	return &SlaStatsTable{
+		db,
+	}
+}
+
+// Record counts a met or breached target towards the guild's compliance, and the user's, if userId is not 0. Only
+// the first outcome recorded for each of a ticket's targets is counted, so it is safe to call again on a retry.
+func (s *SlaStatsTable) Record(ctx context.Context, guildId uint64, ticketId int, userId uint64, target SlaTarget, breached bool) error {
+	var met, breachedCount int64 = 1, 0
+	if breached {
+		met, breachedCount = 0, 1
+	}
+
+	outcomeQuery := `
+INSERT INTO sla_outcomes("guild_id", "ticket_id", "target", "breached")
+VALUES($1, $2, $3, $4)
+ON CONFLICT("guild_id", "ticket_id", "target") DO NOTHING;`
+
+	query := `
+INSERT INTO sla_stats("guild_id", "user_id", "target", "met", "breached")
+VALUES($1, $2, $3, $4, $5)
+ON CONFLICT("guild_id", "user_id", "target") DO UPDATE SET
+	"met" = sla_stats."met" + EXCLUDED."met",
+	"breached" = sla_stats."breached" + EXCLUDED."breached";`
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, outcomeQuery, guildId, ticketId, target, breached)
+	if err != nil {
+		return err
+	}
+
+	// Already counted
+	if tag.RowsAffected() == 0 {
+		return nil
+	}
+
+	if _, err := tx.Exec(ctx, query, guildId, 0, target, met, breachedCount); err != nil {
+		return err
+	}
+
+	if userId != 0 {
+		if _, err := tx.Exec(ctx, query, guildId, userId, target, met, breachedCount); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (s *SlaStatsTable) Get(ctx context.Context, guildId uint64) (SlaStats, error) {
+	return s.get(ctx, guildId, 0)
+}
+
+func (s *SlaStatsTable) GetForUser(ctx context.Context, guildId, userId uint64) (SlaStats, error) {
+	return s.get(ctx, guildId, userId)
+}
+
+func (s *SlaStatsTable) get(ctx context.Context, guildId, userId uint64) (SlaStats, error) {
+	query := `SELECT "target", "met", "breached" FROM sla_stats WHERE "guild_id" = $1 AND "user_id" = $2;`
+
+	rows, err := s.Query(ctx, query, guildId, userId)
+	if err != nil {
+		return SlaStats{}, err
+	}
+	defer rows.Close()
+
+	var stats SlaStats
+	for rows.Next() {
+		var target SlaTarget
+		var met, breached int64
+		if err := rows.Scan(&target, &met, &breached); err != nil {
+			return SlaStats{}, err
+		}
+
+		switch target {
+		case SlaTargetFirstResponse:
+			stats.FirstResponseMet, stats.FirstResponseBreached = met, breached
+		case SlaTargetResolution:
+			stats.ResolutionMet, stats.ResolutionBreached = met, breached
+		}
+	}
+
+	return stats, rows.Err()
+}
+
+// FirstResponseCompliance returns the percentage of first response targets met, or nil if none were tracked
+func (s SlaStats) FirstResponseCompliance() *float64 {
+	return compliance(s.FirstResponseMet, s.FirstResponseBreached)
+}
+
+// ResolutionCompliance returns the percentage of resolution targets met, or nil if none were tracked
+func (s SlaStats) ResolutionCompliance() *float64 {
+	return compliance(s.ResolutionMet, s.ResolutionBreached)
+}
+
+func compliance(met, breached int64) *float64 {
+	if met+breached == 0 {
+		return nil
+	}
+
+	percentage := float64(met) * 100 / float64(met+breached)
+	return &percentage
+}
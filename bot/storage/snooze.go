@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type (
+	SnoozeSettingsTable struct {
+		*pgxpool.Pool
+	}
+
+	// SnoozeSettings configures snoozing for a guild. A CategoryId of 0 leaves snoozed channels where they are.
+	SnoozeSettings struct {
+		CategoryId uint64
+		ShowButton bool
+	}
+
+	TicketSnoozes struct {
+		*pgxpool.Pool
+	}
+
+	// TicketSnooze records a snoozed ticket, along with the state needed to restore it when the snooze ends
+	TicketSnooze struct {
+		Until              time.Time
+		SnoozedBy          uint64
+		PreviousCategoryId *uint64
+		SlaRemaining       map[SlaTarget]time.Duration
+	}
+)
+
+func newSnoozeSettingsTable(db *pgxpool.Pool) *SnoozeSettingsTable {
+	return &SnoozeSettingsTable{
+		db,
+	}
+}
+
+func (s *SnoozeSettingsTable) Get(ctx context.Context, guildId uint64) (settings SnoozeSettings, e error) {
+	query := `SELECT "category_id", "show_button" FROM snooze_settings WHERE "guild_id" = $1;`
+	if err := s.QueryRow(ctx, query, guildId).Scan(&settings.CategoryId, &settings.ShowButton); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		e = err
+	}
+
+	return
+}
+
+func (s *SnoozeSettingsTable) Set(ctx context.Context, guildId uint64, settings SnoozeSettings) (err error) {
+	query := `
+INSERT INTO snooze_settings("guild_id", "category_id", "show_button")
+VALUES($1, $2, $3)
+ON CONFLICT("guild_id") DO UPDATE SET "category_id" = $2, "show_button" = $3;`
+
+	_, err = s.Exec(ctx, query, guildId, settings.CategoryId, settings.ShowButton)
+	return
+}
+
+func newTicketSnoozes(db *pgxpool.Pool) *TicketSnoozes {
+	return &TicketSnoozes{
+		db,
+	}
+}
+
+func (t *TicketSnoozes) Get(ctx context.Context, guildId uint64, ticketId int) (TicketSnooze, bool, error) {
+	query := `SELECT "until", "snoozed_by", "previous_category_id", "sla_remaining" FROM ticket_snoozes WHERE "guild_id" = $1 AND "ticket_id" = $2;`
+	return t.scan(t.QueryRow(ctx, query, guildId, ticketId))
+}
+
+func (t *TicketSnoozes) IsSnoozed(ctx context.Context, guildId uint64, ticketId int) (snoozed bool, err error) {
+	query := `SELECT EXISTS(SELECT 1 FROM ticket_snoozes WHERE "guild_id" = $1 AND "ticket_id" = $2);`
+	err = t.QueryRow(ctx, query, guildId, ticketId).Scan(&snoozed)
+	return
+}
+
+func (t *TicketSnoozes) Set(ctx context.Context, guildId uint64, ticketId int, snooze TicketSnooze) (err error) {
+	query := `
+INSERT INTO ticket_snoozes("guild_id", "ticket_id", "until", "snoozed_by", "previous_category_id", "sla_remaining")
+VALUES($1, $2, $3, $4, $5, $6)
+ON CONFLICT("guild_id", "ticket_id") DO UPDATE SET
+	"until" = $3,
+	"snoozed_by" = $4,
+	"previous_category_id" = $5,
+	"sla_remaining" = $6;`
+
+	_, err = t.Exec(ctx, query, guildId, ticketId, snooze.Until, snooze.SnoozedBy, snooze.PreviousCategoryId, snooze.SlaRemaining)
+	return
+}
+
+// Delete ends the ticket's snooze, returning false if it was not snoozed
+func (t *TicketSnoozes) Delete(ctx context.Context, guildId uint64, ticketId int) (bool, error) {
+	query := `DELETE FROM ticket_snoozes WHERE "guild_id" = $1 AND "ticket_id" = $2;`
+
+	tag, err := t.Exec(ctx, query, guildId, ticketId)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (t *TicketSnoozes) scan(row pgx.Row) (TicketSnooze, bool, error) {
+	var snooze TicketSnooze
+	if err := row.Scan(&snooze.Until, &snooze.SnoozedBy, &snooze.PreviousCategoryId, &snooze.SlaRemaining); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return TicketSnooze{}, false, nil
+		}
+
+		return TicketSnooze{}, false, err
+	}
+
+	return snooze, true, nil
+}
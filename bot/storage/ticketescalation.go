@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type (
+	TicketEscalations struct {
+		*pgxpool.Pool
+	}
+
+	// TicketEscalation records the teams a ticket has been escalated to with /escalate. Level is the number of times
+	// the ticket has been escalated, and TeamId is the team it is currently escalated to.
+	TicketEscalation struct {
+		Level   int
+		TeamId  int
+		History []TicketEscalationLog
+	}
+
+	TicketEscalationLog struct {
+		TeamId int       `json:"team_id"`
+		UserId uint64    `json:"user_id"`
+		Reason string    `json:"reason,omitempty"`
+		Time   time.Time `json:"time"`
+	}
+
+	EscalationStats struct {
+		Tickets     int64
+		Escalations int64
+	}
+)
+
+func newTicketEscalations(db *pgxpool.Pool) *TicketEscalations {
+	return &TicketEscalations{
+		db,
+	}
+}
+
+// Get returns the ticket's escalation, which has a Level of 0 if it has never been escalated
+func (t *TicketEscalations) Get(ctx context.Context, guildId uint64, ticketId int) (escalation TicketEscalation, e error) {
+	query := `SELECT "level", "team_id", "history" FROM ticket_escalations WHERE "guild_id" = $1 AND "ticket_id" = $2;`
+	if err := t.QueryRow(ctx, query, guildId, ticketId).Scan(&escalation.Level, &escalation.TeamId, &escalation.History); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		e = err
+	}
+
+	return
+}
+
+// Set stores the ticket's escalation, counting it towards the guild's escalation stats
+func (t *TicketEscalations) Set(ctx context.Context, guildId uint64, ticketId int, escalation TicketEscalation) error {
+	tx, err := t.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback(ctx)
+
+	query := `
+INSERT INTO ticket_escalations("guild_id", "ticket_id", "level", "team_id", "history")
+VALUES($1, $2, $3, $4, $5)
+ON CONFLICT("guild_id", "ticket_id") DO UPDATE SET "level" = $3, "team_id" = $4, "history" = $5;`
+
+	if _, err := tx.Exec(ctx, query, guildId, ticketId, escalation.Level, escalation.TeamId, escalation.History); err != nil {
+		return err
+	}
+
+	var newTickets int64
+	if escalation.Level == 1 {
+		newTickets = 1
+	}
+
+	statsQuery := `
+INSERT INTO escalation_stats("guild_id", "tickets", "escalations")
+VALUES($1, $2, 1)
+ON CONFLICT("guild_id") DO UPDATE SET
+	"tickets" = escalation_stats."tickets" + EXCLUDED."tickets",
+	"escalations" = escalation_stats."escalations" + 1;`
+
+	if _, err := tx.Exec(ctx, statsQuery, guildId, newTickets); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (t *TicketEscalations) GetStats(ctx context.Context, guildId uint64) (stats EscalationStats, e error) {
+	query := `SELECT "tickets", "escalations" FROM escalation_stats WHERE "guild_id" = $1;`
+	if err := t.QueryRow(ctx, query, guildId).Scan(&stats.Tickets, &stats.Escalations); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		e = err
+	}
+
+	return
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"unicode/utf8"
+
+	"github.com/TicketsBot-cloud/gdl/objects/channel/embed"
+)
+
+// Limits that Discord places on embeds, counted in characters
+const (
+	MaxEmbedsPerMessage = 10
+	MaxEmbedCharacters  = 6000
+	MaxEmbedFields      = 25
+	MaxEmbedFieldName   = 256
+	MaxEmbedFieldValue  = 1024
+	MaxEmbedDescription = 4096
+)
+
+// TruncateCharacters shortens s to at most max characters, ending it with an ellipsis if it was cut. Unlike
+// StringMax, the limit is counted in characters, and a multi-byte character is never split.
+func TruncateCharacters(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+
+	return string([]rune(s)[:max-3]) + "..."
+}
+
+// EmbedLength returns the number of characters in the embed that count towards Discord's limit on the total size of
+// the embeds in a message
+func EmbedLength(e *embed.Embed) int {
+	length := utf8.RuneCountInString(e.Title) + utf8.RuneCountInString(e.Description)
+	for _, field := range e.Fields {
+		length += utf8.RuneCountInString(field.Name) + utf8.RuneCountInString(field.Value)
+	}
+
+	if e.Footer != nil {
+		length += utf8.RuneCountInString(e.Footer.Text)
+	}
+
+	if e.Author != nil {
+		length += utf8.RuneCountInString(e.Author.Name)
+	}
+
+	return length
+}
+
+// BatchEmbeds splits embeds, in order, into as few messages as possible without any message holding more than
+// MaxEmbedsPerMessage embeds or MaxEmbedCharacters characters across its embeds
+func BatchEmbeds(embeds []*embed.Embed) [][]*embed.Embed {
+	var batches [][]*embed.Embed
+	var batch []*embed.Embed
+	var batchLength int
+
+	for _, e := range embeds {
+		length := EmbedLength(e)
+		if len(batch) > 0 && (len(batch) == MaxEmbedsPerMessage || batchLength+length > MaxEmbedCharacters) {
+			batches = append(batches, batch)
+			batch, batchLength = nil, 0
+		}
+
+		batch = append(batch, e)
+		batchLength += length
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/TicketsBot-cloud/gdl/objects/channel/embed"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTruncateCharacters(t *testing.T) {
+	require.Equal(t, "hello", TruncateCharacters("hello", 5))
+	require.Equal(t, "he...", TruncateCharacters("hello!", 5))
+	require.Equal(t, "éé...", TruncateCharacters(strings.Repeat("é", 10), 5))
+}
+
+func TestBatchEmbeds(t *testing.T) {
+	small := func() *embed.Embed {
+		return embed.NewEmbed().SetDescription("hi")
+	}
+
+	large := func() *embed.Embed {
+		return embed.NewEmbed().SetDescription(strings.Repeat("a", 2000))
+	}
+
+	require.Empty(t, BatchEmbeds(nil))
+
+	var embeds []*embed.Embed
+	for i := 0; i < 12; i++ {
+		embeds = append(embeds, small())
+	}
+
+	batches := BatchEmbeds(embeds)
+	require.Len(t, batches, 2)
+	require.Len(t, batches[0], MaxEmbedsPerMessage)
+	require.Len(t, batches[1], 2)
+
+	// Three 2000 character embeds fit in a message, but a fourth would exceed the total size
+	batches = BatchEmbeds([]*embed.Embed{large(), large(), large(), large(), small()})
+	require.Len(t, batches, 2)
+	require.Len(t, batches[0], 3)
+	require.Len(t, batches[1], 2)
+}
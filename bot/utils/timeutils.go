@@ -1,13 +1,39 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 )
 
+var ErrInvalidDuration = errors.New("invalid duration")
+
+var durationUnits = map[string]time.Duration{
+	"w": time.Hour * 24 * 7,
+	"d": time.Hour * 24,
+	"h": time.Hour,
+	"m": time.Minute,
+	"s": time.Second,
+}
+
+// dateLayouts are the formats accepted by ParseTimeOrDuration for absolute times, which are interpreted as UTC
+var dateLayouts = []string{
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
 func FormatTime(interval time.Duration) string {
 	minutes := (interval.Milliseconds() / (1000 * 60)) % 60
 	hours := (interval.Milliseconds() / (1000 * 60 * 60)) % 24
+	days := interval.Milliseconds() / (1000 * 60 * 60 * 24)
+
+	if days > 0 {
+		return fmt.Sprintf("%dd %dh %02dm", days, hours, minutes)
+	}
 
 	return fmt.Sprintf("%dh %02dm", hours, minutes)
 }
@@ -24,3 +50,55 @@ func FormatNullableTime(duration *time.Duration) string {
 		return FormatTime(*duration)
 	}
 }
+
+// ParseDuration parses a human written duration such as "3d", "2h30m" or "1w 2d", with units of weeks, days,
+// hours, minutes and seconds
+func ParseDuration(s string) (time.Duration, error) {
+	s = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(s), " ", ""))
+	if s == "" {
+		return 0, ErrInvalidDuration
+	}
+
+	var total time.Duration
+	for len(s) > 0 {
+		numberEnd := strings.IndexFunc(s, func(r rune) bool {
+			return !unicode.IsDigit(r)
+		})
+
+		// A number must be followed by a unit
+		if numberEnd <= 0 {
+			return 0, ErrInvalidDuration
+		}
+
+		value, err := strconv.Atoi(s[:numberEnd])
+		if err != nil {
+			return 0, ErrInvalidDuration
+		}
+
+		unit, ok := durationUnits[s[numberEnd:numberEnd+1]]
+		if !ok {
+			return 0, ErrInvalidDuration
+		}
+
+		total += time.Duration(value) * unit
+		s = s[numberEnd+1:]
+	}
+
+	return total, nil
+}
+
+// ParseTimeOrDuration parses either a duration understood by ParseDuration, which is added to now, or a UTC date
+// in the form "2006-01-02" or "2006-01-02 15:04"
+func ParseTimeOrDuration(s string, now time.Time) (time.Time, error) {
+	if duration, err := ParseDuration(s); err == nil {
+		return now.Add(duration), nil
+	}
+
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, strings.TrimSpace(s)); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, ErrInvalidDuration
+}
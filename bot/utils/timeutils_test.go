@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDuration(t *testing.T) {
+	duration, err := ParseDuration("3d")
+	require.NoError(t, err)
+	require.Equal(t, time.Hour*72, duration)
+}
+
+func TestParseDurationCompound(t *testing.T) {
+	duration, err := ParseDuration("1w 2d 3h 30m")
+	require.NoError(t, err)
+	require.Equal(t, time.Hour*24*9+time.Hour*3+time.Minute*30, duration)
+}
+
+func TestParseDurationInvalid(t *testing.T) {
+	for _, input := range []string{"", "3", "d", "3x", "3d4", "-1h"} {
+		_, err := ParseDuration(input)
+		require.ErrorIs(t, err, ErrInvalidDuration, input)
+	}
+}
+
+func TestParseTimeOrDurationRelative(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	until, err := ParseTimeOrDuration("2h", now)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(time.Hour*2), until)
+}
+
+func TestParseTimeOrDurationDate(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	until, err := ParseTimeOrDuration("2026-10-20 09:30", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 10, 20, 9, 30, 0, 0, time.UTC), until)
+
+	until, err = ParseTimeOrDuration("2026-10-20", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC), until)
+}
+
+func TestFormatTime(t *testing.T) {
+	require.Equal(t, "2h 05m", FormatTime(time.Hour*2+time.Minute*5))
+	require.Equal(t, "3d 1h 00m", FormatTime(time.Hour*73))
+}
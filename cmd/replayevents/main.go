@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TicketsBot-cloud/archiverclient"
+	"github.com/TicketsBot-cloud/common/observability"
+	"github.com/TicketsBot-cloud/common/premium"
+	"github.com/TicketsBot-cloud/gdl/rest/request"
+	"github.com/TicketsBot-cloud/worker/bot/cache"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/integrations"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/bot/utils"
+	"github.com/TicketsBot-cloud/worker/config"
+	"github.com/TicketsBot-cloud/worker/event"
+	"github.com/TicketsBot-cloud/worker/i18n"
+	"go.uber.org/zap"
+
+	_ "github.com/joho/godotenv/autoload"
+)
+
+var (
+	Ids       = flag.String("id", "", "Comma separated list of dead-letter entry IDs to replay")
+	EventType = flag.String("type", "", "Only replay entries with this event type, e.g. MESSAGE_CREATE")
+	Since     = flag.String("since", "", "Only replay entries that failed at or after this time (RFC3339)")
+	Until     = flag.String("until", "", "Only replay entries that failed at or before this time (RFC3339)")
+	Limit     = flag.Int64("limit", 100, "Maximum number of entries to select, 0 for no limit")
+	ListOnly  = flag.Bool("list", false, "Print the selected entries without replaying them")
+	Keep      = flag.Bool("keep", false, "Keep entries in the dead-letter store after a successful replay")
+)
+
+func main() {
+	flag.Parse()
+	config.Parse()
+
+	logger, err := observability.Configure(nil, config.Conf.JsonLogs, config.Conf.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+
+	must(redis.Connect())
+
+	entries := must2(selectEntries(context.Background()))
+	if len(entries) == 0 {
+		logger.Info("No dead-letter entries matched")
+		return
+	}
+
+	if *ListOnly {
+		for _, entry := range entries {
+			fmt.Printf("%s\t%s\t%s\tattempts=%d\t%s\n", entry.Id, entry.FailedAt.Format(time.RFC3339), entry.EventType, entry.Attempts, entry.Error)
+		}
+
+		return
+	}
+
+	dbclient.Connect(logger.With(zap.String("service", "database")))
+	i18n.Init()
+
+	pgCache := must2(cache.Connect(logger.With(zap.String("service", "cache"))))
+	cache.Client = &pgCache
+
+	if config.Conf.Discord.ProxyUrl != "" {
+		request.Client.Timeout = config.Conf.Discord.RequestTimeout
+		request.RegisterPreRequestHook(utils.ProxyHook)
+	}
+
+	utils.PremiumClient = premium.NewPremiumLookupClient(redis.Client, &pgCache, dbclient.Client)
+	utils.ArchiverClient = archiverclient.NewArchiverClient(
+		archiverclient.NewProxyRetriever(config.Conf.Archiver.Url),
+		[]byte(config.Conf.Archiver.AesKey),
+	)
+	integrations.InitIntegrations()
+
+	var succeeded, failed int
+	for _, entry := range entries {
+		if err := event.ReplayDeadLetter(context.Background(), &pgCache, entry); err != nil {
+			failed++
+			logger.Warn("Replay failed", zap.String("id", entry.Id), zap.String("event_type", entry.EventType), zap.Error(err))
+
+			entry.Attempts++
+			entry.Error = err.Error()
+			if err := redis.UpdateDeadLetter(context.Background(), entry); err != nil {
+				logger.Error("Failed to update dead-letter entry", zap.String("id", entry.Id), zap.Error(err))
+			}
+
+			continue
+		}
+
+		succeeded++
+		logger.Info("Replayed event", zap.String("id", entry.Id), zap.String("event_type", entry.EventType))
+
+		if !*Keep {
+			if err := redis.DeleteDeadLetters(context.Background(), entry.Id); err != nil {
+				logger.Error("Failed to delete dead-letter entry", zap.String("id", entry.Id), zap.Error(err))
+			}
+		}
+	}
+
+	logger.Info("Replay complete", zap.Int("succeeded", succeeded), zap.Int("failed", failed))
+}
+
+func selectEntries(ctx context.Context) ([]redis.DeadLetter, error) {
+	var entries []redis.DeadLetter
+
+	if *Ids != "" {
+		for _, id := range strings.Split(*Ids, ",") {
+			entry, ok, err := redis.GetDeadLetter(ctx, strings.TrimSpace(id))
+			if err != nil {
+				return nil, err
+			}
+
+			if !ok {
+				return nil, fmt.Errorf("dead-letter entry %s does not exist", id)
+			}
+
+			entries = append(entries, entry)
+		}
+	} else {
+		since, err := parseTime(*Since)
+		if err != nil {
+			return nil, err
+		}
+
+		until, err := parseTime(*Until)
+		if err != nil {
+			return nil, err
+		}
+
+		// Filter by type after fetching, so the limit cannot be applied in Redis
+		limit := *Limit
+		if *EventType != "" {
+			limit = 0
+		}
+
+		entries, err = redis.GetDeadLetters(ctx, since, until, limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if *EventType != "" {
+		filtered := make([]redis.DeadLetter, 0, len(entries))
+		for _, entry := range entries {
+			if strings.EqualFold(entry.EventType, *EventType) {
+				filtered = append(filtered, entry)
+			}
+		}
+
+		entries = filtered
+	}
+
+	if *Limit > 0 && int64(len(entries)) > *Limit {
+		entries = entries[:*Limit]
+	}
+
+	return entries, nil
+}
+
+func parseTime(raw string) (time.Time, error) {
+	if raw == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, raw)
+}
+
+func must(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func must2[T any](t T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+
+	return t
+}
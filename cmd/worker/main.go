@@ -1,12 +1,15 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
 	"os/signal"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -27,10 +30,12 @@ import (
 	"github.com/TicketsBot-cloud/worker/bot/metrics/statsd"
 	"github.com/TicketsBot-cloud/worker/bot/redis"
 	"github.com/TicketsBot-cloud/worker/bot/rpc/listeners"
+	"github.com/TicketsBot-cloud/worker/bot/scheduler"
 	"github.com/TicketsBot-cloud/worker/bot/utils"
 	"github.com/TicketsBot-cloud/worker/config"
 	"github.com/TicketsBot-cloud/worker/event"
 	"github.com/TicketsBot-cloud/worker/i18n"
+	"github.com/twmb/franz-go/pkg/kgo"
 	"go.uber.org/zap"
 
 	_ "github.com/joho/godotenv/autoload"
@@ -153,72 +158,223 @@ func main() {
 	logger.Info("Initialising integrations")
 	integrations.InitIntegrations()
 
-	go messagequeue.ListenTicketClose()
-	go messagequeue.ListenAutoClose(logger.With(zap.String("service", "autoclose")))
-	go messagequeue.ListenCloseRequestTimer(logger.With(zap.String("service", "close-request-timer")))
-	go messagequeue.ListenCloseReasonUpdate()
+	queueCtx, stopQueueListeners := context.WithCancel(context.Background())
+
+	var queueWg sync.WaitGroup
+	for _, listen := range []func(context.Context){
+		messagequeue.ListenTicketClose,
+		func(ctx context.Context) {
+			messagequeue.ListenAutoClose(ctx, logger.With(zap.String("service", "autoclose")))
+		},
+		func(ctx context.Context) {
+			messagequeue.ListenCloseRequestTimer(ctx, logger.With(zap.String("service", "close-request-timer")))
+		},
+		messagequeue.ListenCloseReasonUpdate,
+		func(ctx context.Context) {
+			scheduler.Run(ctx, logger.With(zap.String("service", "timers")))
+		},
+	} {
+		queueWg.Add(1)
+		go func() {
+			defer queueWg.Done()
+			listen(queueCtx)
+		}()
+	}
 
 	go blacklist.StartCacheRefreshLoop(logger.With(zap.String("service", "blacklist_refresh")))
 
+	if len(config.Conf.HttpAuth.Secrets) == 0 {
+		if !config.Conf.HttpAuth.Insecure {
+			logger.Fatal("No HTTP auth secrets configured, set WORKER_HTTP_AUTH_INSECURE to accept unsigned requests")
+		}
+
+		logger.Warn("No HTTP auth secrets configured, requests to the HTTP listener will not be verified")
+	}
+
+	httpServer := event.NewHttpServer(redis.Client, &pgCache)
+
+	var rpcClient *rpc.Client
+	var kafkaHealthClient *kgo.Client
+	var rpcWg sync.WaitGroup
+	var executor *event.PartitionedExecutor
+
 	if config.Conf.WorkerMode == config.WorkerModeInteractions {
 		logger.Info("Starting HTTP server", zap.String("mode", string(config.Conf.WorkerMode)))
-
-		event.HttpListen(redis.Client, &pgCache)
 	} else if config.Conf.WorkerMode == config.WorkerModeGateway {
 		logger.Info("Starting event listeners", zap.String("mode", string(config.Conf.WorkerMode)))
 
-		go event.HttpListen(redis.Client, &pgCache)
+		rpcListeners, err := listeners.Build(&pgCache, logger, config.Conf.Kafka.RpcListeners, config.Conf.Kafka.RpcTopics)
+		if err != nil {
+			logger.Fatal("Failed to build RPC listeners", zap.Error(err))
+			return
+		}
+
+		consumerConcurrency := config.Conf.Kafka.GoroutineLimit
+
+		// Listen for gateway events over Kafka, unless this worker only consumes RPC topics
+		if config.Conf.Kafka.EventsTopic != "" {
+			if _, ok := rpcListeners[config.Conf.Kafka.EventsTopic]; ok {
+				logger.Fatal("RPC listener topic conflicts with events topic", zap.String("topic", config.Conf.Kafka.EventsTopic))
+				return
+			}
 
-		var wg sync.WaitGroup
+			kafkaListener := event.NewKafkaListener(
+				logger.With(zap.String("service", "gateway-events-kafka")),
+				&pgCache,
+			)
+
+			if config.Conf.Kafka.Partitions > 0 {
+				keyType := event.PartitionKey(config.Conf.Kafka.PartitionKey)
+				if keyType != event.PartitionKeyGuild && keyType != event.PartitionKeyChannel {
+					logger.Fatal("Invalid Kafka partition key", zap.String("key", config.Conf.Kafka.PartitionKey))
+					return
+				}
+
+				logger.Info(
+					"Processing gateway events in order",
+					zap.Int("partitions", config.Conf.Kafka.Partitions),
+					zap.String("key", string(keyType)),
+				)
+
+				executor = event.NewPartitionedExecutor(config.Conf.Kafka.Partitions, config.Conf.Kafka.PartitionQueueSize)
+				kafkaListener.WithPartitionedExecutor(executor, keyType)
+
+				// Events must be handed to the executor in the order they were polled, so the consumer must not
+				// process records in parallel. Concurrency is provided by the executor's partitions instead.
+				consumerConcurrency = 1
+				for topic, listener := range rpcListeners {
+					rpcListeners[topic] = listeners.NewAsyncListener(listener)
+				}
+			}
+
+			rpcListeners[config.Conf.Kafka.EventsTopic] = kafkaListener
+		}
+
+		if len(rpcListeners) == 0 {
+			logger.Fatal("No Kafka events topic or RPC listeners configured")
+			return
+		}
+
+		topics := make([]string, 0, len(rpcListeners))
+		for topic := range rpcListeners {
+			topics = append(topics, topic)
+		}
 
-		rpcClient, err := rpc.NewClient(
+		logger.Info("Consuming Kafka topics", zap.Strings("topics", topics))
+
+		rpcClient, err = rpc.NewClient(
 			logger.With(zap.String("service", "rpc")),
 			rpc.Config{
 				Brokers:             config.Conf.Kafka.Brokers,
 				ConsumerGroup:       "worker",
-				ConsumerConcurrency: config.Conf.Kafka.GoroutineLimit,
+				ConsumerConcurrency: consumerConcurrency,
 			},
-			map[string]rpc.Listener{
-				// Listen for gateway events over Kafka
-				config.Conf.Kafka.EventsTopic: event.NewKafkaListener(
-					logger.With(zap.String("service", "gateway-events-kafka")),
-					&pgCache,
-				),
-				// TODO: Don't hardcode
-				"tickets.rpc.categoryupdate": listeners.NewTicketStatusUpdater(&pgCache, logger),
-			})
+			rpcListeners,
+		)
 
 		if err != nil {
 			logger.Fatal("Failed to create RPC client", zap.Error(err))
 			return
 		}
 
-		wg.Add(1)
+		var rpcRunning atomic.Bool
+		rpcRunning.Store(true)
+
+		rpcWg.Add(1)
 		go func() {
-			defer wg.Done()
+			defer rpcWg.Done()
+			defer rpcRunning.Store(false)
 			rpcClient.StartConsumer()
 		}()
 
-		shutdownCh := make(chan os.Signal, 1)
-		signal.Notify(shutdownCh, syscall.SIGINT, syscall.SIGTERM)
-		<-shutdownCh
+		// The RPC client does not expose its connection, so brokers are reached through a separate client without a
+		// consumer group, which only sends metadata requests
+		kafkaHealthClient, err = kgo.NewClient(kgo.SeedBrokers(config.Conf.Kafka.Brokers...))
+		if err != nil {
+			logger.Fatal("Failed to create Kafka health check client", zap.Error(err))
+			return
+		}
 
-		logger.Info("Received shutdown signal")
-		rpcClient.Shutdown()
+		httpServer.AddReadinessCheck("kafka", func(ctx context.Context) error {
+			if !rpcRunning.Load() {
+				return errors.New("rpc consumer is not running")
+			}
 
-		if waitTimeout(&wg, time.Second*10) {
-			logger.Info("Shutdown completed gracefully")
-		} else {
-			logger.Warn("Graceful shutdown timed out, exiting now")
+			if err := kafkaHealthClient.Ping(ctx); err != nil {
+				return fmt.Errorf("kafka brokers are unreachable: %w", err)
+			}
+
+			return nil
+		})
+	} else {
+		logger.Fatal("Invalid worker mode", zap.String("mode", string(config.Conf.WorkerMode)))
+	}
+
+	go func() {
+		if err := httpServer.ListenAndServe(); err != nil {
+			logger.Fatal("HTTP server failed", zap.Error(err))
 		}
+	}()
+
+	shutdownCh := make(chan os.Signal, 1)
+	signal.Notify(shutdownCh, syscall.SIGINT, syscall.SIGTERM)
+	<-shutdownCh
+
+	logger.Info("Received shutdown signal", zap.Duration("timeout", config.Conf.ShutdownTimeout))
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), config.Conf.ShutdownTimeout)
+	defer cancel()
+
+	// Stop accepting new work from every source first, then drain
+	if rpcClient != nil {
+		rpcClient.Shutdown()
+	}
+
+	if kafkaHealthClient != nil {
+		kafkaHealthClient.Close()
+	}
 
-		// Flush any buffered sentry events before exit
-		if !sentry.Flush(2 * time.Second) {
-			logger.Warn("Sentry flush timed out, some events may be lost")
+	stopQueueListeners()
+
+	graceful := true
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		logger.Warn("Failed to drain HTTP server", zap.Error(err))
+		graceful = false
+	}
+
+	if !waitTimeout(&queueWg, time.Until(deadline(shutdownCtx))) {
+		logger.Warn("Timed out waiting for message queue listeners")
+		graceful = false
+	}
+
+	if !waitTimeout(&rpcWg, time.Until(deadline(shutdownCtx))) {
+		logger.Warn("Timed out waiting for RPC consumer")
+		graceful = false
+	}
+
+	// The consumer has stopped handing events to the executor, so the partition queues can now be drained
+	if executor != nil {
+		if err := executor.Shutdown(shutdownCtx); err != nil {
+			logger.Warn("Timed out draining partitioned event executor", zap.Error(err))
+			graceful = false
 		}
+	}
+
+	if graceful {
+		logger.Info("Shutdown completed gracefully")
 	} else {
-		logger.Fatal("Invalid worker mode", zap.String("mode", string(config.Conf.WorkerMode)))
+		logger.Warn("Graceful shutdown timed out, exiting now")
 	}
+
+	// Flush any buffered sentry events before exit
+	if !sentry.Flush(2 * time.Second) {
+		logger.Warn("Sentry flush timed out, some events may be lost")
+	}
+}
+
+func deadline(ctx context.Context) time.Time {
+	deadline, _ := ctx.Deadline()
+	return deadline
 }
 
 func waitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
@@ -17,7 +17,9 @@ type (
 		LogLevel    zapcore.Level `env:"WORKER_LOG_LEVEL" envDefault:"info"`
 		PremiumOnly bool          `env:"WORKER_PREMIUM_ONLY" envDefault:"false"`
 
-		WorkerMode WorkerMode `env:"WORKER_MODE"`
+		WorkerMode             WorkerMode    `env:"WORKER_MODE"`
+		ShutdownTimeout        time.Duration `env:"WORKER_SHUTDOWN_TIMEOUT" envDefault:"25s"`
+		ShutdownReadinessDelay time.Duration `env:"WORKER_SHUTDOWN_READINESS_DELAY" envDefault:"5s"`
 
 		Discord struct {
 			Token            string        `env:"WORKER_PUBLIC_TOKEN"`
@@ -44,6 +46,20 @@ type (
 			MonitoredBots       []uint64 `env:"MONITORED_BOTS"`
 		}
 
+		HttpAuth struct {
+			Secrets []string      `env:"SECRETS"`
+			MaxSkew time.Duration `env:"MAX_SKEW" envDefault:"30s"`
+			// Insecure accepts unsigned requests if no secrets are configured, for local development only
+			Insecure    bool  `env:"INSECURE" envDefault:"false"`
+			MaxBodySize int64 `env:"MAX_BODY_SIZE" envDefault:"16777216"`
+		} `envPrefix:"WORKER_HTTP_AUTH_"`
+
+		// Bounds the gateway events received over HTTP that are waiting to be processed
+		HttpEventQueue struct {
+			Workers int `env:"WORKERS" envDefault:"100"`
+			Size    int `env:"SIZE" envDefault:"1000"`
+		} `envPrefix:"WORKER_HTTP_EVENT_QUEUE_"`
+
 		PremiumProxy struct {
 			Url string `env:"URL"`
 			Key string `env:"KEY"`
@@ -98,8 +114,36 @@ type (
 			Brokers        []string `env:"BROKERS"`
 			EventsTopic    string   `env:"EVENTS_TOPIC"`
 			GoroutineLimit int      `env:"GOROUTINE_LIMIT" envDefault:"1000"`
+
+			// If Partitions > 0, gateway events are processed in order per PartitionKey ("guild" or "channel")
+			Partitions         int    `env:"PARTITIONS" envDefault:"0"`
+			PartitionQueueSize int    `env:"PARTITION_QUEUE_SIZE" envDefault:"1000"`
+			PartitionKey       string `env:"PARTITION_KEY" envDefault:"guild"`
+
+			// Names of the RPC listeners to run, as registered in bot/rpc/listeners. Leave EventsTopic empty to
+			// run a worker that only consumes RPC topics.
+			RpcListeners []string `env:"RPC_LISTENERS" envDefault:"categoryupdate"`
+			// Overrides the topic a listener consumes, in the format name:topic,name:topic
+			RpcTopics map[string]string `env:"RPC_TOPICS"`
 		} `envPrefix:"KAFKA_"`
 
+		DeadLetter struct {
+			Enabled    bool  `env:"ENABLED" envDefault:"true"`
+			MaxEntries int64 `env:"MAX_ENTRIES" envDefault:"10000"`
+		} `envPrefix:"WORKER_DEAD_LETTER_"`
+
+		Timers struct {
+			PollInterval time.Duration `env:"POLL_INTERVAL" envDefault:"1s"`
+			BatchSize    int           `env:"BATCH_SIZE" envDefault:"100"`
+			MaxAttempts  int           `env:"MAX_ATTEMPTS" envDefault:"5"`
+			RetryBackoff time.Duration `env:"RETRY_BACKOFF" envDefault:"30s"`
+		} `envPrefix:"WORKER_TIMERS_"`
+
+		EventDeduplication struct {
+			Enabled bool          `env:"ENABLED" envDefault:"true"`
+			Ttl     time.Duration `env:"TTL" envDefault:"15m"`
+		} `envPrefix:"WORKER_EVENT_DEDUPLICATION_"`
+
 		Prometheus struct {
 			Address string `env:"PROMETHEUS_SERVER_ADDR"`
 		}
@@ -13,6 +13,10 @@ type Context struct {
 	ShardId      int
 	Cache        *cache.PgCache
 	RateLimiter  *ratelimit.Ratelimiter
+
+	// Redelivered is set if the event being handled has already been processed by a worker, e.g. because Kafka
+	// redelivered it. Listeners with side effects that are not idempotent should skip them if it is set.
+	Redelivered bool
 }
 
 func (ctx *Context) Self() (user.User, error) {
@@ -283,6 +283,64 @@ func callCommand(
 		}
 
 		v.Execute(ctx, arg0)
+	case settings.AutoAssignCommand:
+
+		v.Execute(ctx)
+	case settings.AutoAssignDisableCommand:
+		var arg0 int
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt0.Name)
+			}
+			arg0 = int(argValue)
+		}
+
+		v.Execute(ctx, arg0)
+	case settings.AutoAssignSetCommand:
+		var arg0 int
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt0.Name)
+			}
+			arg0 = int(argValue)
+		}
+		var arg1 string
+
+		opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+		if !ok1 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt1.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt1.Name)
+			}
+			arg1 = argValue
+		}
+		var arg2 *int
+
+		opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+		if !ok2 {
+			arg2 = nil
+		} else {
+			argValue, ok := opt2.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt2.Name)
+			}
+			tmp := int(argValue)
+			arg2 = &tmp
+		}
+
+		v.Execute(ctx, arg0, arg1, arg2)
 	case settings.AutoCloseCommand:
 
 		v.Execute(ctx)
@@ -292,61 +350,839 @@ func callCommand(
 	case settings.AutoCloseExcludeCommand:
 
 		v.Execute(ctx)
-	case settings.BlacklistCommand:
-		var arg0 uint64
+	case settings.AutoCloseWarningCommand:
+		var arg0 int
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt0.Name)
+			}
+			arg0 = int(argValue)
+		}
+		var arg1 *bool
+
+		opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+		if !ok1 {
+			arg1 = nil
+		} else {
+			argValue, ok := opt1.Value.(bool)
+			if !ok {
+				return fmt.Errorf("option %s was not a bool", opt1.Name)
+			}
+			arg1 = &argValue
+
+		}
+
+		v.Execute(ctx, arg0, arg1)
+	case settings.BlacklistCommand:
+		var arg0 uint64
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			raw, ok := opt0.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a snowflake", opt0.Name)
+			}
+
+			argValue, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				return fmt.Errorf("option %s was not a valid snowflake", opt0.Name)
+			}
+			arg0 = argValue
+		}
+
+		v.Execute(ctx, arg0)
+	case settings.ClaimLimitCommand:
+
+		v.Execute(ctx)
+	case settings.ClaimLimitRemoveCommand:
+		var arg0 *int
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			arg0 = nil
+		} else {
+			argValue, ok := opt0.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt0.Name)
+			}
+			tmp := int(argValue)
+			arg0 = &tmp
+		}
+
+		v.Execute(ctx, arg0)
+	case settings.ClaimLimitSetCommand:
+		var arg0 int
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt0.Name)
+			}
+			arg0 = int(argValue)
+		}
+		var arg1 *int
+
+		opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+		if !ok1 {
+			arg1 = nil
+		} else {
+			argValue, ok := opt1.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt1.Name)
+			}
+			tmp := int(argValue)
+			arg1 = &tmp
+		}
+
+		v.Execute(ctx, arg0, arg1)
+	case settings.EscalationCommand:
+
+		v.Execute(ctx)
+	case settings.EscalationDisableCommand:
+
+		v.Execute(ctx)
+	case settings.EscalationSetCommand:
+		var arg0 int
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt0.Name)
+			}
+			arg0 = int(argValue)
+		}
+		var arg1 string
+
+		opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+		if !ok1 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt1.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt1.Name)
+			}
+			arg1 = argValue
+		}
+		var arg2 *int
+
+		opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+		if !ok2 {
+			arg2 = nil
+		} else {
+			argValue, ok := opt2.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt2.Name)
+			}
+			tmp := int(argValue)
+			arg2 = &tmp
+		}
+		var arg3 *uint64
+
+		opt3, ok3 := findOption(cmd.Properties().Arguments[3], options)
+		if !ok3 {
+			arg3 = nil
+		} else {
+			raw, ok := opt3.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a snowflake", opt3.Name)
+			}
+
+			argValue, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				return fmt.Errorf("option %s was not a valid snowflake", opt3.Name)
+			}
+			arg3 = &argValue
+		}
+		var arg4 *int
+
+		opt4, ok4 := findOption(cmd.Properties().Arguments[4], options)
+		if !ok4 {
+			arg4 = nil
+		} else {
+			argValue, ok := opt4.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt4.Name)
+			}
+			tmp := int(argValue)
+			arg4 = &tmp
+		}
+		var arg5 *int
+
+		opt5, ok5 := findOption(cmd.Properties().Arguments[5], options)
+		if !ok5 {
+			arg5 = nil
+		} else {
+			argValue, ok := opt5.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt5.Name)
+			}
+			tmp := int(argValue)
+			arg5 = &tmp
+		}
+
+		v.Execute(ctx, arg0, arg1, arg2, arg3, arg4, arg5)
+	case settings.FormFlowBranchCommand:
+		var arg0 int
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt0.Name)
+			}
+			arg0 = int(argValue)
+		}
+		var arg1 int
+
+		opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+		if !ok1 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt1.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt1.Name)
+			}
+			arg1 = int(argValue)
+		}
+		var arg2 string
+
+		opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+		if !ok2 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt2.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt2.Name)
+			}
+			arg2 = argValue
+		}
+		var arg3 int
+
+		opt3, ok3 := findOption(cmd.Properties().Arguments[3], options)
+		if !ok3 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt3.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt3.Name)
+			}
+			arg3 = int(argValue)
+		}
+
+		v.Execute(ctx, arg0, arg1, arg2, arg3)
+	case settings.FormFlowClearCommand:
+		var arg0 int
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt0.Name)
+			}
+			arg0 = int(argValue)
+		}
+
+		v.Execute(ctx, arg0)
+	case settings.FormFlowCommand:
+
+		v.Execute(ctx)
+	case settings.FormFlowDefaultCommand:
+		var arg0 int
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt0.Name)
+			}
+			arg0 = int(argValue)
+		}
+		var arg1 *int
+
+		opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+		if !ok1 {
+			arg1 = nil
+		} else {
+			argValue, ok := opt1.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt1.Name)
+			}
+			tmp := int(argValue)
+			arg1 = &tmp
+		}
+
+		v.Execute(ctx, arg0, arg1)
+	case settings.FormValidationCommand:
+
+		v.Execute(ctx)
+	case settings.FormValidationRemoveCommand:
+		var arg0 int
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt0.Name)
+			}
+			arg0 = int(argValue)
+		}
+
+		v.Execute(ctx, arg0)
+	case settings.FormValidationSetCommand:
+		var arg0 int
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt0.Name)
+			}
+			arg0 = int(argValue)
+		}
+		var arg1 string
+
+		opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+		if !ok1 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt1.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt1.Name)
+			}
+			arg1 = argValue
+		}
+		var arg2 *string
+
+		opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+		if !ok2 {
+			arg2 = nil
+		} else {
+			argValue, ok := opt2.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt2.Name)
+			}
+			arg2 = &argValue
+		}
+		var arg3 *string
+
+		opt3, ok3 := findOption(cmd.Properties().Arguments[3], options)
+		if !ok3 {
+			arg3 = nil
+		} else {
+			argValue, ok := opt3.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt3.Name)
+			}
+			arg3 = &argValue
+		}
+		var arg4 *int
+
+		opt4, ok4 := findOption(cmd.Properties().Arguments[4], options)
+		if !ok4 {
+			arg4 = nil
+		} else {
+			argValue, ok := opt4.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt4.Name)
+			}
+			tmp := int(argValue)
+			arg4 = &tmp
+		}
+		var arg5 *int
+
+		opt5, ok5 := findOption(cmd.Properties().Arguments[5], options)
+		if !ok5 {
+			arg5 = nil
+		} else {
+			argValue, ok := opt5.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt5.Name)
+			}
+			tmp := int(argValue)
+			arg5 = &tmp
+		}
+		var arg6 *float64
+
+		opt6, ok6 := findOption(cmd.Properties().Arguments[6], options)
+		if !ok6 {
+			arg6 = nil
+		} else {
+			argValue, ok := opt6.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt6.Name)
+			}
+			arg6 = &argValue
+		}
+		var arg7 *float64
+
+		opt7, ok7 := findOption(cmd.Properties().Arguments[7], options)
+		if !ok7 {
+			arg7 = nil
+		} else {
+			argValue, ok := opt7.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt7.Name)
+			}
+			arg7 = &argValue
+		}
+
+		v.Execute(ctx, arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	case settings.LanguageCommand:
+
+		v.Execute(ctx)
+	case settings.PanelCommand:
+
+		v.Execute(ctx)
+	case settings.PremiumCommand:
+
+		v.Execute(ctx)
+	case settings.PrioritySettingsCommand:
+
+		v.Execute(ctx)
+	case settings.PrioritySettingsGeneralCommand:
+		var arg0 *string
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			arg0 = nil
+		} else {
+			argValue, ok := opt0.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt0.Name)
+			}
+			arg0 = &argValue
+		}
+		var arg1 *uint64
+
+		opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+		if !ok1 {
+			arg1 = nil
+		} else {
+			raw, ok := opt1.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a snowflake", opt1.Name)
+			}
+
+			argValue, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				return fmt.Errorf("option %s was not a valid snowflake", opt1.Name)
+			}
+			arg1 = &argValue
+		}
+		var arg2 *bool
+
+		opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+		if !ok2 {
+			arg2 = nil
+		} else {
+			argValue, ok := opt2.Value.(bool)
+			if !ok {
+				return fmt.Errorf("option %s was not a bool", opt2.Name)
+			}
+			arg2 = &argValue
+
+		}
+
+		v.Execute(ctx, arg0, arg1, arg2)
+	case settings.PrioritySettingsPanelDefaultCommand:
+		var arg0 int
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt0.Name)
+			}
+			arg0 = int(argValue)
+		}
+		var arg1 string
+
+		opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+		if !ok1 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt1.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt1.Name)
+			}
+			arg1 = argValue
+		}
+
+		v.Execute(ctx, arg0, arg1)
+	case settings.RemoveAdminCommand:
+		var arg0 uint64
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			raw, ok := opt0.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a snowflake", opt0.Name)
+			}
+
+			argValue, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				return fmt.Errorf("option %s was not a valid snowflake", opt0.Name)
+			}
+			arg0 = argValue
+		}
+
+		v.Execute(ctx, arg0)
+	case settings.RemoveSupportCommand:
+		var arg0 uint64
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			raw, ok := opt0.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a snowflake", opt0.Name)
+			}
+
+			argValue, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				return fmt.Errorf("option %s was not a valid snowflake", opt0.Name)
+			}
+			arg0 = argValue
+		}
+
+		v.Execute(ctx, arg0)
+	case settings.RoutingAddCommand:
+		var arg0 string
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt0.Name)
+			}
+			arg0 = argValue
+		}
+		var arg1 *int
+
+		opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+		if !ok1 {
+			arg1 = nil
+		} else {
+			argValue, ok := opt1.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt1.Name)
+			}
+			tmp := int(argValue)
+			arg1 = &tmp
+		}
+		var arg2 *string
+
+		opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+		if !ok2 {
+			arg2 = nil
+		} else {
+			argValue, ok := opt2.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt2.Name)
+			}
+			arg2 = &argValue
+		}
+		var arg3 *string
+
+		opt3, ok3 := findOption(cmd.Properties().Arguments[3], options)
+		if !ok3 {
+			arg3 = nil
+		} else {
+			argValue, ok := opt3.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt3.Name)
+			}
+			arg3 = &argValue
+		}
+		var arg4 *uint64
+
+		opt4, ok4 := findOption(cmd.Properties().Arguments[4], options)
+		if !ok4 {
+			arg4 = nil
+		} else {
+			raw, ok := opt4.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a snowflake", opt4.Name)
+			}
+
+			argValue, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				return fmt.Errorf("option %s was not a valid snowflake", opt4.Name)
+			}
+			arg4 = &argValue
+		}
+		var arg5 *string
+
+		opt5, ok5 := findOption(cmd.Properties().Arguments[5], options)
+		if !ok5 {
+			arg5 = nil
+		} else {
+			argValue, ok := opt5.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt5.Name)
+			}
+			arg5 = &argValue
+		}
+		var arg6 *string
+
+		opt6, ok6 := findOption(cmd.Properties().Arguments[6], options)
+		if !ok6 {
+			arg6 = nil
+		} else {
+			argValue, ok := opt6.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt6.Name)
+			}
+			arg6 = &argValue
+		}
+		var arg7 *string
+
+		opt7, ok7 := findOption(cmd.Properties().Arguments[7], options)
+		if !ok7 {
+			arg7 = nil
+		} else {
+			argValue, ok := opt7.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt7.Name)
+			}
+			arg7 = &argValue
+		}
+		var arg8 *uint64
+
+		opt8, ok8 := findOption(cmd.Properties().Arguments[8], options)
+		if !ok8 {
+			arg8 = nil
+		} else {
+			raw, ok := opt8.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a snowflake", opt8.Name)
+			}
+
+			argValue, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				return fmt.Errorf("option %s was not a valid snowflake", opt8.Name)
+			}
+			arg8 = &argValue
+		}
+		var arg9 *int
+
+		opt9, ok9 := findOption(cmd.Properties().Arguments[9], options)
+		if !ok9 {
+			arg9 = nil
+		} else {
+			argValue, ok := opt9.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt9.Name)
+			}
+			tmp := int(argValue)
+			arg9 = &tmp
+		}
+		var arg10 *uint64
+
+		opt10, ok10 := findOption(cmd.Properties().Arguments[10], options)
+		if !ok10 {
+			arg10 = nil
+		} else {
+			raw, ok := opt10.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a snowflake", opt10.Name)
+			}
+
+			argValue, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				return fmt.Errorf("option %s was not a valid snowflake", opt10.Name)
+			}
+			arg10 = &argValue
+		}
+		var arg11 *string
+
+		opt11, ok11 := findOption(cmd.Properties().Arguments[11], options)
+		if !ok11 {
+			arg11 = nil
+		} else {
+			argValue, ok := opt11.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt11.Name)
+			}
+			arg11 = &argValue
+		}
+		var arg12 *int
+
+		opt12, ok12 := findOption(cmd.Properties().Arguments[12], options)
+		if !ok12 {
+			arg12 = nil
+		} else {
+			argValue, ok := opt12.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt12.Name)
+			}
+			tmp := int(argValue)
+			arg12 = &tmp
+		}
+
+		v.Execute(ctx, arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12)
+	case settings.RoutingCommand:
+
+		v.Execute(ctx)
+	case settings.RoutingDryRunCommand:
+		var arg0 bool
 
 		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
 		if !ok0 {
 			return ErrArgumentNotFound
 		} else {
-			raw, ok := opt0.Value.(string)
+			argValue, ok := opt0.Value.(bool)
 			if !ok {
-				return fmt.Errorf("option %s was not a snowflake", opt0.Name)
+				return fmt.Errorf("option %s was not a bool", opt0.Name)
+			}
+			arg0 = argValue
+
+		}
+		var arg1 *uint64
+
+		opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+		if !ok1 {
+			arg1 = nil
+		} else {
+			raw, ok := opt1.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a snowflake", opt1.Name)
 			}
 
 			argValue, err := strconv.ParseUint(raw, 10, 64)
 			if err != nil {
-				return fmt.Errorf("option %s was not a valid snowflake", opt0.Name)
+				return fmt.Errorf("option %s was not a valid snowflake", opt1.Name)
+			}
+			arg1 = &argValue
+		}
+
+		v.Execute(ctx, arg0, arg1)
+	case settings.RoutingListCommand:
+
+		v.Execute(ctx)
+	case settings.RoutingRemoveCommand:
+		var arg0 string
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt0.Name)
 			}
 			arg0 = argValue
 		}
 
 		v.Execute(ctx, arg0)
-	case settings.LanguageCommand:
+	case settings.SlaCommand:
 
 		v.Execute(ctx)
-	case settings.PanelCommand:
+	case settings.SlaRemoveCommand:
+		var arg0 int
 
-		v.Execute(ctx)
-	case settings.PremiumCommand:
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt0.Name)
+			}
+			arg0 = int(argValue)
+		}
 
-		v.Execute(ctx)
-	case settings.RemoveAdminCommand:
-		var arg0 uint64
+		v.Execute(ctx, arg0)
+	case settings.SlaSetCommand:
+		var arg0 int
 
 		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
 		if !ok0 {
 			return ErrArgumentNotFound
 		} else {
-			raw, ok := opt0.Value.(string)
+			argValue, ok := opt0.Value.(float64)
 			if !ok {
-				return fmt.Errorf("option %s was not a snowflake", opt0.Name)
+				return fmt.Errorf("option %s was not a float64", opt0.Name)
+			}
+			arg0 = int(argValue)
+		}
+		var arg1 *int
+
+		opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+		if !ok1 {
+			arg1 = nil
+		} else {
+			argValue, ok := opt1.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt1.Name)
 			}
+			tmp := int(argValue)
+			arg1 = &tmp
+		}
+		var arg2 *int
 
-			argValue, err := strconv.ParseUint(raw, 10, 64)
-			if err != nil {
-				return fmt.Errorf("option %s was not a valid snowflake", opt0.Name)
+		opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+		if !ok2 {
+			arg2 = nil
+		} else {
+			argValue, ok := opt2.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt2.Name)
 			}
-			arg0 = argValue
+			tmp := int(argValue)
+			arg2 = &tmp
 		}
+		var arg3 *int
 
-		v.Execute(ctx, arg0)
-	case settings.RemoveSupportCommand:
-		var arg0 uint64
+		opt3, ok3 := findOption(cmd.Properties().Arguments[3], options)
+		if !ok3 {
+			arg3 = nil
+		} else {
+			argValue, ok := opt3.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt3.Name)
+			}
+			tmp := int(argValue)
+			arg3 = &tmp
+		}
+
+		v.Execute(ctx, arg0, arg1, arg2, arg3)
+	case settings.SnoozeSettingsCommand:
+		var arg0 *uint64
 
 		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
 		if !ok0 {
-			return ErrArgumentNotFound
+			arg0 = nil
 		} else {
 			raw, ok := opt0.Value.(string)
 			if !ok {
@@ -357,10 +1193,23 @@ func callCommand(
 			if err != nil {
 				return fmt.Errorf("option %s was not a valid snowflake", opt0.Name)
 			}
-			arg0 = argValue
+			arg0 = &argValue
 		}
+		var arg1 *bool
 
-		v.Execute(ctx, arg0)
+		opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+		if !ok1 {
+			arg1 = nil
+		} else {
+			argValue, ok := opt1.Value.(bool)
+			if !ok {
+				return fmt.Errorf("option %s was not a bool", opt1.Name)
+			}
+			arg1 = &argValue
+
+		}
+
+		v.Execute(ctx, arg0, arg1)
 	case settings.ViewStaffCommand:
 
 		v.Execute(ctx)
@@ -563,8 +1412,20 @@ func callCommand(
 			}
 			arg0 = &argValue
 		}
+		var arg1 *string
 
-		v.Execute(ctx, arg0)
+		opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+		if !ok1 {
+			arg1 = nil
+		} else {
+			argValue, ok := opt1.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt1.Name)
+			}
+			arg1 = &argValue
+		}
+
+		v.Execute(ctx, arg0, arg1)
 	case tickets.CloseRequestCommand:
 		var arg0 *int
 
@@ -596,6 +1457,48 @@ func callCommand(
 	case tickets.EditCommand:
 
 		v.Execute(ctx)
+	case tickets.EscalateCommand:
+		var arg0 int
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt0.Name)
+			}
+			arg0 = int(argValue)
+		}
+		var arg1 *string
+
+		opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+		if !ok1 {
+			arg1 = nil
+		} else {
+			argValue, ok := opt1.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt1.Name)
+			}
+			arg1 = &argValue
+		}
+
+		v.Execute(ctx, arg0, arg1)
+	case tickets.MergeCommand:
+		var arg0 int
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt0.Name)
+			}
+			arg0 = int(argValue)
+		}
+
+		v.Execute(ctx, arg0)
 	case tickets.NotesCommand:
 
 		v.Execute(ctx)
@@ -616,6 +1519,21 @@ func callCommand(
 			arg0 = &argValue
 		}
 
+		v.Execute(ctx, arg0)
+	case tickets.PriorityCommand:
+		var arg0 string
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt0.Name)
+			}
+			arg0 = argValue
+		}
+
 		v.Execute(ctx, arg0)
 	case tickets.RemoveCommand:
 		var arg0 uint64
@@ -666,6 +1584,21 @@ func callCommand(
 			arg0 = int(argValue)
 		}
 
+		v.Execute(ctx, arg0)
+	case tickets.SnoozeCommand:
+		var arg0 string
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			return ErrArgumentNotFound
+		} else {
+			argValue, ok := opt0.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a string", opt0.Name)
+			}
+			arg0 = argValue
+		}
+
 		v.Execute(ctx, arg0)
 	case tickets.StartTicketCommand:
 
@@ -685,6 +1618,87 @@ func callCommand(
 		}
 
 		v.Execute(ctx, arg0)
+	case tickets.TicketCommand:
+
+		v.Execute(ctx)
+	case tickets.TicketInfoCommand:
+
+		v.Execute(ctx)
+	case tickets.TicketsCommand:
+
+		v.Execute(ctx)
+	case tickets.TicketsListCommand:
+		var arg0 *bool
+
+		opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+		if !ok0 {
+			arg0 = nil
+		} else {
+			argValue, ok := opt0.Value.(bool)
+			if !ok {
+				return fmt.Errorf("option %s was not a bool", opt0.Name)
+			}
+			arg0 = &argValue
+
+		}
+		var arg1 *bool
+
+		opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+		if !ok1 {
+			arg1 = nil
+		} else {
+			argValue, ok := opt1.Value.(bool)
+			if !ok {
+				return fmt.Errorf("option %s was not a bool", opt1.Name)
+			}
+			arg1 = &argValue
+
+		}
+		var arg2 *int
+
+		opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+		if !ok2 {
+			arg2 = nil
+		} else {
+			argValue, ok := opt2.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt2.Name)
+			}
+			tmp := int(argValue)
+			arg2 = &tmp
+		}
+		var arg3 *uint64
+
+		opt3, ok3 := findOption(cmd.Properties().Arguments[3], options)
+		if !ok3 {
+			arg3 = nil
+		} else {
+			raw, ok := opt3.Value.(string)
+			if !ok {
+				return fmt.Errorf("option %s was not a snowflake", opt3.Name)
+			}
+
+			argValue, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				return fmt.Errorf("option %s was not a valid snowflake", opt3.Name)
+			}
+			arg3 = &argValue
+		}
+		var arg4 *int
+
+		opt4, ok4 := findOption(cmd.Properties().Arguments[4], options)
+		if !ok4 {
+			arg4 = nil
+		} else {
+			argValue, ok := opt4.Value.(float64)
+			if !ok {
+				return fmt.Errorf("option %s was not a float64", opt4.Name)
+			}
+			tmp := int(argValue)
+			arg4 = &tmp
+		}
+
+		v.Execute(ctx, arg0, arg1, arg2, arg3, arg4)
 	case tickets.TransferCommand:
 		var arg0 uint64
 
@@ -708,6 +1722,9 @@ func callCommand(
 	case tickets.UnclaimCommand:
 
 		v.Execute(ctx)
+	case tickets.UnsnoozeCommand:
+
+		v.Execute(ctx)
 
 	case tags.TagAliasCommand:
 		v.Execute(ctx)
@@ -116,7 +116,7 @@ func executeCommand(
 		}
 	}
 
-	go func() {
+	goInFlight(func() {
 		defer func() {
 			if r := recover(); r != nil {
 				fmt.Printf("Recovering panicking goroutine while executing command %s: %v\n", properties.Name, r)
@@ -250,7 +250,7 @@ func executeCommand(
 				return
 			}
 		}
-	}()
+	})
 
 	return properties.DisableAutoDefer, properties.DefaultEphemeral, nil
 }
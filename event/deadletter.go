@@ -0,0 +1,116 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/eventforwarding"
+	"github.com/TicketsBot-cloud/gdl/cache"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
+	"github.com/TicketsBot-cloud/worker/bot/metrics/prometheus"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/config"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const unknownEventType = "UNKNOWN"
+
+// deadLetter stores a payload that could not be processed, so that it can be re-fed through execute by
+// cmd/replayevents once the cause has been fixed. The bot token is stripped from the payload before it is stored, and
+// looked up again by bot ID when the entry is replayed.
+func deadLetter(logger *zap.Logger, message []byte, cause error) {
+	if !config.Conf.DeadLetter.Enabled {
+		return
+	}
+
+	payload, err := stripBotToken(message)
+	if err != nil {
+		logger.Error("Failed to dead-letter event", zap.Error(err), zap.String("event_type", eventTypeOf(message)))
+		return
+	}
+
+	entry := redis.DeadLetter{
+		Id:        uuid.NewString(),
+		EventType: eventTypeOf(message),
+		Payload:   payload,
+		Error:     cause.Error(),
+		Attempts:  1,
+		FailedAt:  time.Now(),
+	}
+
+	prometheus.DeadLetteredEvents.WithLabelValues(entry.EventType).Inc()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+
+	if err := redis.PushDeadLetter(ctx, entry, config.Conf.DeadLetter.MaxEntries); err != nil {
+		logger.Error("Failed to dead-letter event", zap.Error(err), zap.String("event_type", entry.EventType))
+		return
+	}
+
+	logger.Debug("Dead-lettered event", zap.String("id", entry.Id), zap.String("event_type", entry.EventType))
+}
+
+// ReplayDeadLetter re-runs a dead-lettered payload through the event executor. The entry is not modified;
+// the caller is responsible for removing or updating it based on the result. Deduplication is bypassed, as the
+// failed delivery may have already been marked as processed.
+func ReplayDeadLetter(ctx context.Context, cache *cache.PgCache, entry redis.DeadLetter) error {
+	var event eventforwarding.Event
+	if err := json.Unmarshal(entry.Payload, &event); err != nil {
+		return err
+	}
+
+	token, err := botToken(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	event.BotToken = token
+
+	return execute(forwardedContext(cache, event), event.Event, false)
+}
+
+// stripBotToken removes the bot token from a forwarded event, so that it is not persisted. Payloads that can't be
+// parsed are not stored at all, as they can't be replayed and may still contain the token.
+func stripBotToken(message []byte) ([]byte, error) {
+	var event eventforwarding.Event
+	if err := json.Unmarshal(message, &event); err != nil {
+		return nil, err
+	}
+
+	event.BotToken = ""
+	return json.Marshal(event)
+}
+
+func botToken(ctx context.Context, event eventforwarding.Event) (string, error) {
+	if !event.IsWhitelabel {
+		return config.Conf.Discord.Token, nil
+	}
+
+	bot, err := dbclient.Client.Whitelabel.GetByBotId(ctx, event.BotId)
+	if err != nil {
+		return "", err
+	}
+
+	if bot.BotId == 0 {
+		return "", fmt.Errorf("whitelabel bot %d no longer exists", event.BotId)
+	}
+
+	return bot.Token, nil
+}
+
+func eventTypeOf(message []byte) string {
+	var data struct {
+		Event struct {
+			EventName string `json:"t"`
+		} `json:"event"`
+	}
+
+	if err := json.Unmarshal(message, &data); err != nil || data.Event.EventName == "" {
+		return unknownEventType
+	}
+
+	return data.Event.EventName
+}
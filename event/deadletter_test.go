@@ -0,0 +1,27 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/TicketsBot-cloud/common/eventforwarding"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStripBotToken(t *testing.T) {
+	message := []byte(`{"bot_token":"secret","bot_id":1,"is_whitelabel":true,"shard_id":2,"event":{"t":"MESSAGE_CREATE"}}`)
+
+	payload, err := stripBotToken(message)
+	require.NoError(t, err)
+	require.NotContains(t, string(payload), "secret")
+
+	var event eventforwarding.Event
+	require.NoError(t, json.Unmarshal(payload, &event))
+	require.Equal(t, uint64(1), event.BotId)
+	require.True(t, event.IsWhitelabel)
+	require.Equal(t, "MESSAGE_CREATE", eventTypeOf(payload))
+}
+
+func TestStripBotTokenInvalid(t *testing.T) {
+	_, err := stripBotToken([]byte("not json"))
+	require.Error(t, err)
+}
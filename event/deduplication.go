@@ -0,0 +1,91 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/sentry"
+	"github.com/TicketsBot-cloud/gdl/gateway/payloads"
+	"github.com/TicketsBot-cloud/gdl/gateway/payloads/events"
+	"github.com/TicketsBot-cloud/worker/bot/metrics/prometheus"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/config"
+)
+
+// deduplicatedEvents maps the event types that have listeners opted into deduplication to a function that
+// extracts the ID of the entity the event refers to. Listeners opt in by checking worker.Context.Redelivered,
+// or by being wrapped with listeners.SkipRedelivered.
+var deduplicatedEvents = map[events.EventType]func(payload payloads.Payload) (string, error){
+	events.MESSAGE_CREATE: func(payload payloads.Payload) (string, error) {
+		var data struct {
+			Id string `json:"id"`
+		}
+
+		if err := json.Unmarshal(payload.Data, &data); err != nil {
+			return "", err
+		}
+
+		return data.Id, nil
+	},
+	// Member removals have no ID of their own, so key on the membership
+	events.GUILD_MEMBER_REMOVE: func(payload payloads.Payload) (string, error) {
+		var data struct {
+			GuildId string `json:"guild_id"`
+			User    struct {
+				Id string `json:"id"`
+			} `json:"user"`
+		}
+
+		if err := json.Unmarshal(payload.Data, &data); err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s:%s", data.GuildId, data.User.Id), nil
+	},
+}
+
+// claimEvent marks the event as processed, returning true if it had already been marked within the TTL. If this
+// call set the mark, release removes it again, so that an event whose listeners failed is processed in full when it
+// is retried; otherwise release does nothing. Errors fail open, as processing an event twice is preferable to
+// dropping it.
+func claimEvent(payload payloads.Payload) (redelivered bool, release func()) {
+	release = func() {}
+
+	if !config.Conf.EventDeduplication.Enabled {
+		return false, release
+	}
+
+	entityIdFunc, ok := deduplicatedEvents[events.EventType(payload.EventName)]
+	if !ok {
+		return false, release
+	}
+
+	entityId, err := entityIdFunc(payload)
+	if err != nil || entityId == "" {
+		return false, release // The listener will fail to decode the payload itself
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+
+	first, err := redis.MarkEventProcessed(ctx, payload.EventName, entityId, config.Conf.EventDeduplication.Ttl)
+	if err != nil {
+		sentry.Error(err)
+		return false, release
+	}
+
+	if !first {
+		prometheus.RedeliveredEvents.WithLabelValues(payload.EventName).Inc()
+		return true, release
+	}
+
+	return false, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+		defer cancel()
+
+		if err := redis.UnmarkEventProcessed(ctx, payload.EventName, entityId); err != nil {
+			sentry.Error(err)
+		}
+	}
+}
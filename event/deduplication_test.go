@@ -0,0 +1,110 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TicketsBot-cloud/gdl/gateway/payloads"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/config"
+	"github.com/alicebob/miniredis/v2"
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimEventRedelivered(t *testing.T) {
+	server := setupDeduplicationTest(t)
+
+	payload := messageCreatePayload("1")
+
+	redelivered, _ := claimEvent(payload)
+	require.False(t, redelivered)
+
+	redelivered, _ = claimEvent(payload)
+	require.True(t, redelivered)
+
+	// Other messages are not affected
+	redelivered, _ = claimEvent(messageCreatePayload("2"))
+	require.False(t, redelivered)
+
+	// The mark expires after the TTL
+	server.FastForward(time.Minute + time.Second)
+
+	redelivered, _ = claimEvent(payload)
+	require.False(t, redelivered)
+}
+
+func TestClaimEventRelease(t *testing.T) {
+	setupDeduplicationTest(t)
+
+	payload := messageCreatePayload("1")
+
+	redelivered, release := claimEvent(payload)
+	require.False(t, redelivered)
+
+	// A failed event is processed in full when it is retried
+	release()
+
+	redelivered, release = claimEvent(payload)
+	require.False(t, redelivered)
+
+	// Releasing a redelivery must not remove the mark set by the first delivery
+	redelivered, releaseRedelivery := claimEvent(payload)
+	require.True(t, redelivered)
+	releaseRedelivery()
+
+	redelivered, _ = claimEvent(payload)
+	require.True(t, redelivered)
+
+	release()
+}
+
+func TestClaimEventFailsOpen(t *testing.T) {
+	server := setupDeduplicationTest(t)
+	server.Close()
+
+	payload := messageCreatePayload("1")
+
+	for i := 0; i < 2; i++ {
+		redelivered, release := claimEvent(payload)
+		require.False(t, redelivered)
+		release()
+	}
+}
+
+func TestClaimEventNotDeduplicated(t *testing.T) {
+	setupDeduplicationTest(t)
+
+	payload := payloads.Payload{EventName: "CHANNEL_DELETE", Data: []byte(`{"id":"1"}`)}
+
+	for i := 0; i < 2; i++ {
+		redelivered, _ := claimEvent(payload)
+		require.False(t, redelivered)
+	}
+
+	config.Conf.EventDeduplication.Enabled = false
+
+	for i := 0; i < 2; i++ {
+		redelivered, _ := claimEvent(messageCreatePayload("1"))
+		require.False(t, redelivered)
+	}
+}
+
+func setupDeduplicationTest(t *testing.T) *miniredis.Miniredis {
+	config.Conf.EventDeduplication.Enabled = true
+	config.Conf.EventDeduplication.Ttl = time.Minute
+
+	return useMiniredis(t)
+}
+
+func messageCreatePayload(id string) payloads.Payload {
+	return payloads.Payload{EventName: "MESSAGE_CREATE", Data: []byte(`{"id":"` + id + `"}`)}
+}
+
+// useMiniredis points the redis client at an in-memory server for the duration of the test
+func useMiniredis(t *testing.T) *miniredis.Miniredis {
+	server := miniredis.RunT(t)
+	redis.Client = goredis.NewClient(&goredis.Options{Addr: server.Addr()})
+
+	return server
+}
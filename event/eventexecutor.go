@@ -12,7 +12,10 @@ import (
 	"github.com/getsentry/sentry-go"
 )
 
-func execute(c *worker.Context, event []byte) error {
+// execute runs the listeners for the event. If deduplicate is set, listeners that are not idempotent are told if the
+// event has already been processed; replays of dead-lettered events are not deduplicated, as they are re-run
+// deliberately.
+func execute(c *worker.Context, event []byte, deduplicate bool) error {
 	var payload payloads.Payload
 	if err := json.Unmarshal(event, &payload); err != nil {
 		return errors.New(fmt.Sprintf("error whilst decoding event data: %s (data: %s)", err.Error(), string(event)))
@@ -24,9 +27,32 @@ func execute(c *worker.Context, event []byte) error {
 
 	prometheus.Events.WithLabelValues(payload.EventName).Inc()
 
+	release := func() {}
+	if deduplicate {
+		var redelivered bool
+		redelivered, release = claimEvent(payload)
+
+		if redelivered {
+			// Copy, so that the flag doesn't leak into any other use of the context
+			redeliveredCtx := *c
+			redeliveredCtx.Redelivered = true
+			c = &redeliveredCtx
+		}
+	}
+
+	// Only keep the deduplication mark once the listeners have succeeded, so that if they fail or panic, the retry
+	// is not treated as a redelivery
+	var succeeded bool
+	defer func() {
+		if !succeeded {
+			release()
+		}
+	}()
+
 	if err := listeners.HandleEvent(c, span, payload); err != nil {
 		return err
 	}
 
+	succeeded = true
 	return nil
 }
@@ -0,0 +1,94 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/TicketsBot-cloud/common/eventforwarding"
+	"github.com/TicketsBot-cloud/gdl/cache"
+	"github.com/TicketsBot-cloud/worker/bot/metrics/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+var errQueueFull = errors.New("event queue is full")
+
+// EventQueue processes gateway events received over HTTP on a fixed pool of workers, so that a burst of events
+// cannot exhaust database connections. Events are rejected, rather than queued, once the queue is full.
+type EventQueue struct {
+	cache *cache.PgCache
+	queue chan eventforwarding.Event
+	wg    sync.WaitGroup
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+func NewEventQueue(cache *cache.PgCache, workers, size int) *EventQueue {
+	q := &EventQueue{
+		cache: cache,
+		queue: make(chan eventforwarding.Event, size),
+	}
+
+	q.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer q.wg.Done()
+
+			for event := range q.queue {
+				prometheus.HttpEventQueueDepth.Set(float64(len(q.queue)))
+
+				if err := executeForwarded(q.cache, event); err != nil {
+					marshalled, _ := json.Marshal(event)
+					logrus.Warnf("error executing event: %v (payload: %s)", err, string(marshalled))
+				}
+			}
+		}()
+	}
+
+	return q
+}
+
+// TryEnqueue queues the event without blocking. Returns errQueueFull if there is no space in the queue, or
+// errExecutorShutdown if the queue is no longer accepting events.
+func (q *EventQueue) TryEnqueue(event eventforwarding.Event) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.closed {
+		prometheus.DroppedHttpEvents.WithLabelValues("shutdown").Inc()
+		return errExecutorShutdown
+	}
+
+	select {
+	case q.queue <- event:
+		prometheus.HttpEventQueueDepth.Set(float64(len(q.queue)))
+		return nil
+	default:
+		prometheus.DroppedHttpEvents.WithLabelValues("queue_full").Inc()
+		return errQueueFull
+	}
+}
+
+// Shutdown stops accepting events, and waits for queued events to be processed, or for ctx to expire
+func (q *EventQueue) Shutdown(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.queue)
+	}
+	q.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
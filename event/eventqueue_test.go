@@ -0,0 +1,96 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/eventforwarding"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventQueueFull(t *testing.T) {
+	queue := &EventQueue{queue: make(chan eventforwarding.Event, 1)}
+
+	require.NoError(t, queue.TryEnqueue(eventforwarding.Event{}))
+	require.ErrorIs(t, queue.TryEnqueue(eventforwarding.Event{}), errQueueFull)
+
+	<-queue.queue
+	require.NoError(t, queue.TryEnqueue(eventforwarding.Event{}))
+}
+
+func TestEventQueueShutdown(t *testing.T) {
+	queue := &EventQueue{queue: make(chan eventforwarding.Event, 1)}
+
+	require.NoError(t, queue.Shutdown(context.Background()))
+	require.ErrorIs(t, queue.TryEnqueue(eventforwarding.Event{}), errExecutorShutdown)
+}
+
+func TestEventHandlerQueueFull(t *testing.T) {
+	setupSignatureTest(t, []string{"secret"}, false)
+
+	queue := &EventQueue{queue: make(chan eventforwarding.Event, 1)}
+	router := newEventTestRouter(queue)
+
+	now := time.Now().Unix()
+	first := Sign("secret", now, []byte(`{"bot_id":1}`))
+	second := Sign("secret", now, []byte(`{"bot_id":2}`))
+
+	recorder := doEventRequest(router, `{"bot_id":1}`, first, now)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	recorder = doEventRequest(router, `{"bot_id":2}`, second, now)
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	require.Equal(t, "1", recorder.Header().Get("Retry-After"))
+
+	// The shed request can be retried with the same signature once there is space
+	<-queue.queue
+
+	recorder = doEventRequest(router, `{"bot_id":2}`, second, now)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	// But a processed request still cannot be replayed
+	<-queue.queue
+
+	recorder = doEventRequest(router, `{"bot_id":1}`, first, now)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestEventHandlerShutdown(t *testing.T) {
+	setupSignatureTest(t, []string{"secret"}, false)
+
+	queue := &EventQueue{queue: make(chan eventforwarding.Event, 1)}
+	require.NoError(t, queue.Shutdown(context.Background()))
+
+	router := newEventTestRouter(queue)
+
+	now := time.Now().Unix()
+	signature := Sign("secret", now, []byte(`{}`))
+
+	require.Equal(t, http.StatusServiceUnavailable, doEventRequest(router, `{}`, signature, now).Code)
+}
+
+func newEventTestRouter(queue *EventQueue) *gin.Engine {
+	s := &HttpServer{eventQueue: queue}
+
+	router := gin.New()
+	router.POST("/event", signatureMiddleware, s.eventHandler)
+
+	return router
+}
+
+func doEventRequest(router *gin.Engine, body, signature string, timestamp int64) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/event", strings.NewReader(body))
+	req.Header.Set(HeaderSignature, signature)
+	req.Header.Set(HeaderSignatureTimestamp, strconv.FormatInt(timestamp, 10))
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	return recorder
+}
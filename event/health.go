@@ -0,0 +1,120 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HealthCheck returns a non-nil error if the dependency it checks is unavailable
+type HealthCheck func(ctx context.Context) error
+
+type healthStatus string
+
+const (
+	healthStatusOk          healthStatus = "ok"
+	healthStatusUnavailable healthStatus = "unavailable"
+)
+
+const healthCheckTimeout = time.Second * 2
+
+var errShuttingDown = errors.New("worker is shutting down")
+
+type checkResult struct {
+	Status    healthStatus `json:"status"`
+	LatencyMs float64      `json:"latency_ms"`
+	Error     string       `json:"error,omitempty"`
+}
+
+type readinessResponse struct {
+	Status healthStatus           `json:"status"`
+	Checks map[string]checkResult `json:"checks"`
+}
+
+// AddReadinessCheck registers a dependency that must be healthy for /readyz to succeed
+func (s *HttpServer) AddReadinessCheck(name string, check HealthCheck) {
+	s.checksMu.Lock()
+	defer s.checksMu.Unlock()
+
+	s.checks[name] = check
+}
+
+// livenessHandler only reports whether the process is able to serve requests
+func livenessHandler(c *gin.Context) {
+	c.JSON(200, gin.H{"status": healthStatusOk})
+}
+
+func (s *HttpServer) readinessHandler(c *gin.Context) {
+	s.checksMu.RLock()
+	checks := make(map[string]HealthCheck, len(s.checks))
+	for name, check := range s.checks {
+		checks[name] = check
+	}
+	s.checksMu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), healthCheckTimeout)
+	defer cancel()
+
+	res := readinessResponse{
+		Status: healthStatusOk,
+		Checks: make(map[string]checkResult, len(checks)),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			result := runCheck(ctx, check)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			res.Checks[name] = result
+			if result.Status != healthStatusOk {
+				res.Status = healthStatusUnavailable
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Report dependencies as normal, but fail once shutdown has begun so that we are taken out of rotation
+	if s.shuttingDown.Load() {
+		res.Status = healthStatusUnavailable
+		res.Checks["shutdown"] = checkResult{
+			Status: healthStatusUnavailable,
+			Error:  errShuttingDown.Error(),
+		}
+	}
+
+	if res.Status == healthStatusOk {
+		c.JSON(200, res)
+	} else {
+		c.JSON(503, res)
+	}
+}
+
+func runCheck(ctx context.Context, check HealthCheck) checkResult {
+	start := time.Now()
+	err := check(ctx)
+	latency := float64(time.Since(start).Microseconds()) / 1000
+
+	if err != nil {
+		return checkResult{
+			Status:    healthStatusUnavailable,
+			LatencyMs: latency,
+			Error:     err.Error(),
+		}
+	}
+
+	return checkResult{
+		Status:    healthStatusOk,
+		LatencyMs: latency,
+	}
+}
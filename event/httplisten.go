@@ -2,8 +2,12 @@ package event
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/TicketsBot-cloud/common/eventforwarding"
@@ -17,6 +21,7 @@ import (
 	btn_manager "github.com/TicketsBot-cloud/worker/bot/button/manager"
 	"github.com/TicketsBot-cloud/worker/bot/command"
 	cmd_manager "github.com/TicketsBot-cloud/worker/bot/command/manager"
+	"github.com/TicketsBot-cloud/worker/bot/dbclient"
 	"github.com/TicketsBot-cloud/worker/bot/metrics/prometheus"
 	"github.com/TicketsBot-cloud/worker/bot/utils"
 	"github.com/TicketsBot-cloud/worker/config"
@@ -47,7 +52,20 @@ var successResponse = response{
 	Success: true,
 }
 
-func HttpListen(redis *redis.Client, cache *cache.PgCache) {
+type HttpServer struct {
+	server       *http.Server
+	eventQueue   *EventQueue
+	shuttingDown atomic.Bool
+
+	checksMu sync.RWMutex
+	checks   map[string]HealthCheck
+}
+
+// inFlight tracks work that continues after the HTTP response has been written, such as deferred
+// interaction responses, so that it can be drained on shutdown.
+var inFlight sync.WaitGroup
+
+func NewHttpServer(redis *redis.Client, cache *cache.PgCache) *HttpServer {
 	router := gin.New()
 
 	// Middleware
@@ -58,13 +76,83 @@ func HttpListen(redis *redis.Client, cache *cache.PgCache) {
 		router.Use(gin.Logger())
 	}
 
+	s := &HttpServer{
+		server: &http.Server{
+			Addr:    config.Conf.Bot.HttpAddress,
+			Handler: router,
+		},
+		eventQueue: NewEventQueue(cache, config.Conf.HttpEventQueue.Workers, config.Conf.HttpEventQueue.Size),
+		checks: map[string]HealthCheck{
+			"redis": func(ctx context.Context) error {
+				return redis.Ping(ctx).Err()
+			},
+			"database": dbclient.Ping,
+			"cache":    cache.Ping,
+			"clickhouse": func(ctx context.Context) error {
+				return dbclient.Analytics.Ping(ctx)
+			},
+		},
+	}
+
 	// Routes
-	router.POST("/event", eventHandler(cache))
-	router.POST("/interaction", interactionHandler(redis, cache))
+	router.GET("/healthz", livenessHandler)
+	router.GET("/readyz", s.readinessHandler)
+	router.POST("/event", signatureMiddleware, s.eventHandler)
+	router.POST("/interaction", signatureMiddleware, interactionHandler(redis, cache))
+
+	return s
+}
 
-	if err := router.Run(config.Conf.Bot.HttpAddress); err != nil {
-		panic(err)
+// ListenAndServe blocks until the server fails, or Shutdown is called, in which case nil is returned
+func (s *HttpServer) ListenAndServe() error {
+	if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
 	}
+
+	return nil
+}
+
+// Shutdown marks the worker as not ready, and after ShutdownReadinessDelay stops accepting new requests. It
+// then waits for in-flight requests and the work they have spawned to complete, or for ctx to expire.
+func (s *HttpServer) Shutdown(ctx context.Context) error {
+	s.shuttingDown.Store(true)
+
+	// Keep serving while the orchestrator notices that readiness is failing
+	select {
+	case <-time.After(config.Conf.ShutdownReadinessDelay):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if err := s.server.Shutdown(ctx); err != nil {
+		return err
+	}
+
+	if err := s.eventQueue.Shutdown(ctx); err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		inFlight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// goInFlight runs f in a new goroutine, which Shutdown will wait for
+func goInFlight(f func()) {
+	inFlight.Add(1)
+	go func() {
+		defer inFlight.Done()
+		f()
+	}()
 }
 
 func metricsMiddleware(c *gin.Context) {
@@ -72,31 +160,27 @@ func metricsMiddleware(c *gin.Context) {
 	c.Next()
 }
 
-func eventHandler(cache *cache.PgCache) func(*gin.Context) {
-	return func(c *gin.Context) {
-		var event eventforwarding.Event
-		if err := c.BindJSON(&event); err != nil {
-			sentry.Error(err)
-			c.JSON(400, newErrorResponse(err))
-			return
-		}
+func (s *HttpServer) eventHandler(c *gin.Context) {
+	var event eventforwarding.Event
+	if err := c.BindJSON(&event); err != nil {
+		sentry.Error(err)
+		c.JSON(400, newErrorResponse(err))
+		return
+	}
 
-		workerCtx := &worker.Context{
-			Token:        event.BotToken,
-			BotId:        event.BotId,
-			IsWhitelabel: event.IsWhitelabel,
-			ShardId:      event.ShardId,
-			Cache:        cache,
-			RateLimiter:  nil, // Use http-proxy ratelimit functionality
+	// Tell the forwarder to back off, so that it can retry the event later, or send it to another worker
+	if err := s.eventQueue.TryEnqueue(event); err != nil {
+		if errors.Is(err, errQueueFull) {
+			c.Header("Retry-After", "1")
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, newErrorResponse(err))
+		} else {
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, newErrorResponse(err))
 		}
 
-		c.AbortWithStatusJSON(200, successResponse)
-
-		if err := execute(workerCtx, event.Event); err != nil {
-			marshalled, _ := json.Marshal(event)
-			logrus.Warnf("error executing event: %v (payload: %s)", err, string(marshalled))
-		}
+		return
 	}
+
+	c.AbortWithStatusJSON(200, successResponse)
 }
 
 func interactionHandler(redis *redis.Client, cache *cache.PgCache) func(*gin.Context) {
@@ -165,7 +249,9 @@ func interactionHandler(redis *redis.Client, cache *cache.PgCache) func(*gin.Con
 					ctx.JSON(200, res)
 					ctx.Writer.Flush()
 
-					go handleApplicationCommandResponseAfterDefer(interactionData, worker, responseCh)
+					goInFlight(func() {
+						handleApplicationCommandResponseAfterDefer(interactionData, worker, responseCh)
+					})
 				}
 			} else {
 				var flags uint
@@ -177,7 +263,9 @@ func interactionHandler(redis *redis.Client, cache *cache.PgCache) func(*gin.Con
 				ctx.JSON(200, res)
 				ctx.Writer.Flush()
 
-				go handleApplicationCommandResponseAfterDefer(interactionData, worker, responseCh)
+				goInFlight(func() {
+					handleApplicationCommandResponseAfterDefer(interactionData, worker, responseCh)
+				})
 			}
 
 			prometheus.InteractionTimeToReceive.Observe(calculateTimeToReceive(interactionData.Id).Seconds())
@@ -203,7 +291,10 @@ func interactionHandler(redis *redis.Client, cache *cache.PgCache) func(*gin.Con
 				ctx.Writer.Flush()
 			}
 
-			go handleButtonResponseAfterDefer(interactionData.InteractionMetadata, worker, time.Now(), responseCh)
+			deferredAt := time.Now()
+			goInFlight(func() {
+				handleButtonResponseAfterDefer(interactionData.InteractionMetadata, worker, deferredAt, responseCh)
+			})
 
 			prometheus.InteractionTimeToReceive.Observe(calculateTimeToReceive(interactionData.Id).Seconds())
 			prometheus.InteractionTimeToDefer.Observe(timeToDefer.Seconds())
@@ -277,7 +368,10 @@ func interactionHandler(redis *redis.Client, cache *cache.PgCache) func(*gin.Con
 			responseCh := make(chan button.Response, 1)
 			btn_manager.HandleModalInteraction(ctx, buttonManager, worker, interactionData, responseCh)
 
-			go handleButtonResponseAfterDefer(interactionData.InteractionMetadata, worker, time.Now(), responseCh)
+			deferredAt := time.Now()
+			goInFlight(func() {
+				handleButtonResponseAfterDefer(interactionData.InteractionMetadata, worker, deferredAt, responseCh)
+			})
 		}
 	}
 }
@@ -2,10 +2,12 @@ package event
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/TicketsBot-cloud/common/eventforwarding"
 	"github.com/TicketsBot-cloud/common/rpc"
 	"github.com/TicketsBot-cloud/gdl/cache"
+	"github.com/TicketsBot-cloud/gdl/gateway/payloads"
 	"github.com/TicketsBot-cloud/worker"
 	"go.uber.org/zap"
 )
@@ -13,6 +15,10 @@ import (
 type KafkaConsumer struct {
 	logger *zap.Logger
 	cache  *cache.PgCache
+
+	// If executor is nil, events are processed on the calling goroutine
+	executor     *PartitionedExecutor
+	partitionKey PartitionKey
 }
 
 var _ rpc.Listener = (*KafkaConsumer)(nil)
@@ -24,6 +30,14 @@ func NewKafkaListener(logger *zap.Logger, cache *cache.PgCache) *KafkaConsumer {
 	}
 }
 
+// WithPartitionedExecutor makes the consumer hand events to the executor, so that events sharing a partition
+// key are processed in the order they were received.
+func (k *KafkaConsumer) WithPartitionedExecutor(executor *PartitionedExecutor, key PartitionKey) *KafkaConsumer {
+	k.executor = executor
+	k.partitionKey = key
+	return k
+}
+
 func (k *KafkaConsumer) BuildContext() (context.Context, context.CancelFunc) {
 	return context.WithCancel(context.Background())
 }
@@ -32,19 +46,52 @@ func (k *KafkaConsumer) HandleMessage(ctx context.Context, message []byte) {
 	var event eventforwarding.Event
 	if err := json.Unmarshal(message, &event); err != nil {
 		k.logger.Error("Failed to unmarshal event", zap.Error(err))
+		deadLetter(k.logger, message, err)
+		return
+	}
+
+	if k.executor == nil {
+		k.process(event, message)
 		return
 	}
 
-	workerCtx := &worker.Context{
+	// If the payload can't be decoded, execute will fail and dead-letter it, so the key doesn't matter
+	var payload payloads.Payload
+	_ = json.Unmarshal(event.Event, &payload)
+
+	if err := k.executor.Submit(partitionKeyFor(payload, k.partitionKey), func() {
+		k.process(event, message)
+	}); err != nil {
+		k.logger.Error("Failed to submit event", zap.Error(err))
+		deadLetter(k.logger, message, err)
+	}
+}
+
+func (k *KafkaConsumer) process(event eventforwarding.Event, message []byte) {
+	defer func() {
+		if r := recover(); r != nil {
+			k.logger.Error("Recovered panic while handling event", zap.Any("panic", r))
+			deadLetter(k.logger, message, fmt.Errorf("panic: %v", r))
+		}
+	}()
+
+	if err := executeForwarded(k.cache, event); err != nil {
+		k.logger.Error("Failed to handle event", zap.Error(err))
+		deadLetter(k.logger, message, err)
+	}
+}
+
+func executeForwarded(cache *cache.PgCache, event eventforwarding.Event) error {
+	return execute(forwardedContext(cache, event), event.Event, true)
+}
+
+func forwardedContext(cache *cache.PgCache, event eventforwarding.Event) *worker.Context {
+	return &worker.Context{
 		Token:        event.BotToken,
 		BotId:        event.BotId,
 		IsWhitelabel: event.IsWhitelabel,
 		ShardId:      event.ShardId,
-		Cache:        k.cache,
+		Cache:        cache,
 		RateLimiter:  nil, // Use http-proxy ratelimit functionality
 	}
-
-	if err := execute(workerCtx, event.Event); err != nil {
-		k.logger.Error("Failed to handle event", zap.Error(err))
-	}
 }
@@ -0,0 +1,142 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+
+	"github.com/TicketsBot-cloud/gdl/gateway/payloads"
+	"github.com/TicketsBot-cloud/gdl/gateway/payloads/events"
+	"github.com/TicketsBot-cloud/worker/bot/metrics/prometheus"
+)
+
+type PartitionKey string
+
+const (
+	PartitionKeyGuild   PartitionKey = "guild"
+	PartitionKeyChannel PartitionKey = "channel"
+)
+
+var errExecutorShutdown = errors.New("partitioned executor has been shut down")
+
+// PartitionedExecutor runs tasks that share a key one at a time, in the order they were submitted, while
+// tasks with different keys may run in parallel on other partitions.
+type PartitionedExecutor struct {
+	partitions []chan func()
+	wg         sync.WaitGroup
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+func NewPartitionedExecutor(partitionCount, queueSize int) *PartitionedExecutor {
+	e := &PartitionedExecutor{
+		partitions: make([]chan func(), partitionCount),
+	}
+
+	for i := range e.partitions {
+		ch := make(chan func(), queueSize)
+		e.partitions[i] = ch
+
+		gauge := prometheus.EventPartitionQueueDepth.WithLabelValues(strconv.Itoa(i))
+
+		e.wg.Add(1)
+		go func() {
+			defer e.wg.Done()
+
+			for task := range ch {
+				gauge.Set(float64(len(ch)))
+				task()
+			}
+		}()
+	}
+
+	return e
+}
+
+// Submit queues the task on the partition for key, blocking while that partition's queue is full. Returns
+// errExecutorShutdown if the executor no longer accepts tasks.
+func (e *PartitionedExecutor) Submit(key uint64, task func()) error {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if e.closed {
+		return errExecutorShutdown
+	}
+
+	partition := e.partitionFor(key)
+	ch := e.partitions[partition]
+	ch <- task
+
+	prometheus.EventPartitionQueueDepth.WithLabelValues(strconv.Itoa(partition)).Set(float64(len(ch)))
+	return nil
+}
+
+// Shutdown stops accepting tasks, and waits for queued tasks to complete, or for ctx to expire
+func (e *PartitionedExecutor) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	if !e.closed {
+		e.closed = true
+		for _, ch := range e.partitions {
+			close(ch)
+		}
+	}
+	e.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		e.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (e *PartitionedExecutor) partitionFor(key uint64) int {
+	// The low bits of a snowflake are a per-process sequence number, and so are mostly zero. Mix the bits
+	// (splitmix64 finaliser) before taking the modulus to spread keys evenly.
+	key ^= key >> 30
+	key *= 0xbf58476d1ce4e5b9
+	key ^= key >> 27
+	key *= 0x94d049bb133111eb
+	key ^= key >> 31
+
+	return int(key % uint64(len(e.partitions)))
+}
+
+// partitionKeyFor extracts the ID that events should be ordered by. Events without the requested ID fall back
+// to the guild ID, and events with neither (e.g. READY) are keyed on 0.
+func partitionKeyFor(payload payloads.Payload, keyType PartitionKey) uint64 {
+	var ids struct {
+		Id        string `json:"id"`
+		GuildId   string `json:"guild_id"`
+		ChannelId string `json:"channel_id"`
+	}
+
+	// Some events have a non-string id field, in which case we can still use the others
+	_ = json.Unmarshal(payload.Data, &ids)
+
+	// For these events, the top level id is the ID of the guild or channel itself
+	switch events.EventType(payload.EventName) {
+	case events.GUILD_CREATE, events.GUILD_UPDATE, events.GUILD_DELETE:
+		ids.GuildId = ids.Id
+	case events.CHANNEL_CREATE, events.CHANNEL_UPDATE, events.CHANNEL_DELETE,
+		events.THREAD_CREATE, events.THREAD_UPDATE, events.THREAD_DELETE, events.THREAD_MEMBERS_UPDATE:
+		ids.ChannelId = ids.Id
+	}
+
+	if keyType == PartitionKeyChannel {
+		if id, err := strconv.ParseUint(ids.ChannelId, 10, 64); err == nil {
+			return id
+		}
+	}
+
+	id, _ := strconv.ParseUint(ids.GuildId, 10, 64)
+	return id
+}
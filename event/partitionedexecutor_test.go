@@ -0,0 +1,96 @@
+package event
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/TicketsBot-cloud/gdl/gateway/payloads"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionedExecutorOrdersPerKey(t *testing.T) {
+	executor := NewPartitionedExecutor(4, 16)
+
+	const keys = 8
+	const tasksPerKey = 200
+
+	var mu sync.Mutex
+	order := make(map[uint64][]int)
+
+	for i := 0; i < tasksPerKey; i++ {
+		for key := uint64(1); key <= keys; key++ {
+			key, i := key, i
+			require.NoError(t, executor.Submit(key, func() {
+				mu.Lock()
+				order[key] = append(order[key], i)
+				mu.Unlock()
+			}))
+		}
+	}
+
+	require.NoError(t, executor.Shutdown(context.Background()))
+
+	for key := uint64(1); key <= keys; key++ {
+		require.Len(t, order[key], tasksPerKey)
+		for i, got := range order[key] {
+			require.Equal(t, i, got, "key %d", key)
+		}
+	}
+}
+
+func TestPartitionedExecutorShutdownDrains(t *testing.T) {
+	executor := NewPartitionedExecutor(2, 16)
+
+	var completed atomic.Int32
+	for i := 0; i < 10; i++ {
+		require.NoError(t, executor.Submit(uint64(i), func() {
+			time.Sleep(time.Millisecond * 5)
+			completed.Add(1)
+		}))
+	}
+
+	require.NoError(t, executor.Shutdown(context.Background()))
+	require.EqualValues(t, 10, completed.Load())
+
+	require.ErrorIs(t, executor.Submit(1, func() {}), errExecutorShutdown)
+
+	// Shutting down twice is safe
+	require.NoError(t, executor.Shutdown(context.Background()))
+}
+
+func TestPartitionedExecutorShutdownTimeout(t *testing.T) {
+	executor := NewPartitionedExecutor(1, 1)
+
+	release := make(chan struct{})
+	defer close(release)
+
+	require.NoError(t, executor.Submit(1, func() {
+		<-release
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+	defer cancel()
+
+	require.ErrorIs(t, executor.Shutdown(ctx), context.DeadlineExceeded)
+}
+
+func TestPartitionKeyFor(t *testing.T) {
+	payload := func(eventName string, data string) payloads.Payload {
+		return payloads.Payload{EventName: eventName, Data: []byte(data)}
+	}
+
+	message := payload("MESSAGE_CREATE", `{"id":"1","guild_id":"2","channel_id":"3"}`)
+	require.EqualValues(t, 2, partitionKeyFor(message, PartitionKeyGuild))
+	require.EqualValues(t, 3, partitionKeyFor(message, PartitionKeyChannel))
+
+	// The id of these events is the guild or channel itself
+	require.EqualValues(t, 5, partitionKeyFor(payload("GUILD_UPDATE", `{"id":"5"}`), PartitionKeyGuild))
+	require.EqualValues(t, 6, partitionKeyFor(payload("CHANNEL_DELETE", `{"id":"6","guild_id":"2"}`), PartitionKeyChannel))
+
+	// Events without a channel fall back to the guild
+	require.EqualValues(t, 2, partitionKeyFor(payload("GUILD_MEMBER_REMOVE", `{"guild_id":"2"}`), PartitionKeyChannel))
+	require.EqualValues(t, 0, partitionKeyFor(payload("READY", `{}`), PartitionKeyGuild))
+}
@@ -0,0 +1,151 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/TicketsBot-cloud/common/sentry"
+	"github.com/TicketsBot-cloud/worker/bot/metrics/prometheus"
+	"github.com/TicketsBot-cloud/worker/bot/redis"
+	"github.com/TicketsBot-cloud/worker/config"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	HeaderSignature          = "X-Signature"
+	HeaderSignatureTimestamp = "X-Signature-Timestamp"
+)
+
+var (
+	errNoSecrets        = errors.New("no secrets are configured to verify requests")
+	errMissingSignature = errors.New("missing signature")
+	errInvalidTimestamp = errors.New("invalid signature timestamp")
+	errStaleRequest     = errors.New("request timestamp outside of allowed window")
+	errInvalidSignature = errors.New("invalid signature")
+	errReplayedRequest  = errors.New("request has already been processed")
+)
+
+// Sign produces the signature the forwarder must send in the X-Signature header: the hex encoded
+// HMAC-SHA256 of "<timestamp>.<body>", keyed with the shared secret.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// signatureMiddleware rejects requests that are unsigned, signed with an unknown secret, outside the allowed
+// clock skew, or that have already been seen. If no secrets are configured, all requests are rejected, unless
+// HttpAuth.Insecure is set, in which case verification is skipped.
+func signatureMiddleware(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, config.Conf.HttpAuth.MaxBodySize)
+
+	secrets := config.Conf.HttpAuth.Secrets
+	if len(secrets) == 0 {
+		if config.Conf.HttpAuth.Insecure {
+			c.Next()
+		} else {
+			rejectRequest(c, "no_secrets", errNoSecrets)
+		}
+
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.AbortWithStatusJSON(413, newErrorResponse(err))
+		} else {
+			c.AbortWithStatusJSON(400, newErrorResponse(err))
+		}
+
+		return
+	}
+
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	signature, reason, err := verifySignature(c.GetHeader(HeaderSignature), c.GetHeader(HeaderSignatureTimestamp), body, secrets)
+	if err != nil {
+		rejectRequest(c, reason, err)
+		return
+	}
+
+	// Each signature is only valid for one request. A signature can only pass the timestamp check for
+	// 2 * MaxSkew, so there is no need to remember it for any longer.
+	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Second*3)
+	defer cancel()
+
+	fresh, err := redis.TakeRequestNonce(ctx, signature, config.Conf.HttpAuth.MaxSkew*2)
+	if err != nil {
+		sentry.Error(err)
+		c.AbortWithStatusJSON(500, newErrorResponse(err))
+		return
+	}
+
+	if !fresh {
+		rejectRequest(c, "replayed", errReplayedRequest)
+		return
+	}
+
+	c.Next()
+
+	// The request was shed without being processed, so the forwarder must be able to retry it with the same
+	// signature
+	if status := c.Writer.Status(); status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+		defer cancel()
+
+		if err := redis.ReleaseRequestNonce(ctx, signature); err != nil {
+			sentry.Error(err)
+		}
+	}
+}
+
+// verifySignature returns the matched signature, or a metric label and error describing why it was rejected
+func verifySignature(signature, rawTimestamp string, body []byte, secrets []string) (string, string, error) {
+	if signature == "" || rawTimestamp == "" {
+		return "", "missing", errMissingSignature
+	}
+
+	timestamp, err := strconv.ParseInt(rawTimestamp, 10, 64)
+	if err != nil {
+		return "", "invalid_timestamp", errInvalidTimestamp
+	}
+
+	skew := time.Since(time.Unix(timestamp, 0))
+	if skew > config.Conf.HttpAuth.MaxSkew || skew < -config.Conf.HttpAuth.MaxSkew {
+		return "", "stale", errStaleRequest
+	}
+
+	decoded, err := hex.DecodeString(signature)
+	if err != nil {
+		return "", "invalid_signature", errInvalidSignature
+	}
+
+	// Accept any configured secret, so that secrets can be rotated without downtime
+	for _, secret := range secrets {
+		expected, _ := hex.DecodeString(Sign(secret, timestamp, body))
+		if hmac.Equal(decoded, expected) {
+			// Return the canonical encoding, so that a change of case cannot be used to evade replay detection
+			return hex.EncodeToString(expected), "", nil
+		}
+	}
+
+	return "", "invalid_signature", errInvalidSignature
+}
+
+func rejectRequest(c *gin.Context, reason string, err error) {
+	prometheus.RejectedInboundRequests.WithLabelValues(c.Request.URL.Path, reason).Inc()
+	c.AbortWithStatusJSON(401, newErrorResponse(err))
+}
@@ -0,0 +1,140 @@
+package event
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TicketsBot-cloud/worker/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySignature(t *testing.T) {
+	config.Conf.HttpAuth.MaxSkew = time.Second * 30
+
+	body := []byte(`{"event":{}}`)
+	now := time.Now().Unix()
+
+	signature := Sign("secret", now, body)
+	matched, _, err := verifySignature(signature, strconv.FormatInt(now, 10), body, []string{"secret"})
+	require.NoError(t, err)
+	require.Equal(t, signature, matched)
+
+	// The case of the hex encoding should not produce a different nonce
+	matched, _, err = verifySignature(strings.ToUpper(signature), strconv.FormatInt(now, 10), body, []string{"secret"})
+	require.NoError(t, err)
+	require.Equal(t, signature, matched)
+}
+
+func TestVerifySignatureRotatedSecret(t *testing.T) {
+	config.Conf.HttpAuth.MaxSkew = time.Second * 30
+
+	body := []byte(`{"event":{}}`)
+	now := time.Now().Unix()
+
+	_, _, err := verifySignature(Sign("old", now, body), strconv.FormatInt(now, 10), body, []string{"new", "old"})
+	require.NoError(t, err)
+}
+
+func TestVerifySignatureWrongSecret(t *testing.T) {
+	config.Conf.HttpAuth.MaxSkew = time.Second * 30
+
+	body := []byte(`{"event":{}}`)
+	now := time.Now().Unix()
+
+	_, reason, err := verifySignature(Sign("wrong", now, body), strconv.FormatInt(now, 10), body, []string{"secret"})
+	require.ErrorIs(t, err, errInvalidSignature)
+	require.Equal(t, "invalid_signature", reason)
+}
+
+func TestVerifySignatureTamperedBody(t *testing.T) {
+	config.Conf.HttpAuth.MaxSkew = time.Second * 30
+
+	now := time.Now().Unix()
+
+	signature := Sign("secret", now, []byte(`{"a":1}`))
+	_, _, err := verifySignature(signature, strconv.FormatInt(now, 10), []byte(`{"a":2}`), []string{"secret"})
+	require.ErrorIs(t, err, errInvalidSignature)
+}
+
+func TestVerifySignatureStale(t *testing.T) {
+	config.Conf.HttpAuth.MaxSkew = time.Second * 30
+
+	body := []byte(`{"event":{}}`)
+
+	for _, timestamp := range []int64{time.Now().Add(-time.Minute).Unix(), time.Now().Add(time.Minute).Unix()} {
+		_, reason, err := verifySignature(Sign("secret", timestamp, body), strconv.FormatInt(timestamp, 10), body, []string{"secret"})
+		require.ErrorIs(t, err, errStaleRequest)
+		require.Equal(t, "stale", reason)
+	}
+}
+
+func TestVerifySignatureMissing(t *testing.T) {
+	_, _, err := verifySignature("", "", nil, []string{"secret"})
+	require.ErrorIs(t, err, errMissingSignature)
+
+	_, _, err = verifySignature("abc", "not a number", nil, []string{"secret"})
+	require.ErrorIs(t, err, errInvalidTimestamp)
+}
+
+func TestSignatureMiddlewareReplayed(t *testing.T) {
+	setupSignatureTest(t, []string{"secret"}, false)
+
+	body := `{"event":{}}`
+	now := time.Now().Unix()
+	signature := Sign("secret", now, []byte(body))
+
+	require.Equal(t, http.StatusOK, doSignedRequest(t, body, signature, now))
+	require.Equal(t, http.StatusUnauthorized, doSignedRequest(t, body, signature, now))
+}
+
+func TestSignatureMiddlewareNoSecrets(t *testing.T) {
+	setupSignatureTest(t, nil, false)
+	require.Equal(t, http.StatusUnauthorized, doSignedRequest(t, "{}", "", 0))
+
+	setupSignatureTest(t, nil, true)
+	require.Equal(t, http.StatusOK, doSignedRequest(t, "{}", "", 0))
+}
+
+func TestSignatureMiddlewareBodyTooLarge(t *testing.T) {
+	setupSignatureTest(t, []string{"secret"}, false)
+	config.Conf.HttpAuth.MaxBodySize = 8
+
+	body := `{"event":{"large":true}}`
+	now := time.Now().Unix()
+
+	require.Equal(t, http.StatusRequestEntityTooLarge, doSignedRequest(t, body, Sign("secret", now, []byte(body)), now))
+}
+
+func setupSignatureTest(t *testing.T, secrets []string, insecure bool) {
+	gin.SetMode(gin.TestMode)
+
+	config.Conf.HttpAuth.Secrets = secrets
+	config.Conf.HttpAuth.Insecure = insecure
+	config.Conf.HttpAuth.MaxSkew = time.Second * 30
+	config.Conf.HttpAuth.MaxBodySize = 1024
+
+	useMiniredis(t)
+}
+
+func doSignedRequest(t *testing.T, body, signature string, timestamp int64) int {
+	router := gin.New()
+	router.POST("/event", signatureMiddleware, func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/event", strings.NewReader(body))
+	if signature != "" {
+		req.Header.Set(HeaderSignature, signature)
+		req.Header.Set(HeaderSignatureTimestamp, strconv.FormatInt(timestamp, 10))
+	}
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	return recorder.Code
+}
@@ -0,0 +1,238 @@
+package i18n
+
+// englishDefaults are used for messages that are missing from the en-GB locale file, so that a worker deployed
+// before the locale submodule has been updated does not render a missing translation error. Strings in the locale
+// file take precedence.
+var englishDefaults = map[MessageId]string{
+	TitleSlaWarning:     "SLA Warning",
+	TitleSlaBreach:      "SLA Breached",
+	TitleSla:            "SLA",
+	TitleAutoAssign:     "Auto Assign",
+	TitleClaimLimit:     "Claim Limit",
+	TitleEscalation:     "Escalation",
+	TitleSnooze:         "Snooze",
+	TitleMerge:          "Merge",
+	TitlePriority:       "Priority",
+	TitleFormValidation: "Form Validation",
+	TitleFormFlow:       "Form Flow",
+	TitleRouting:        "Routing",
+
+	MessageClaimLimitReached:         "You have reached the limit of %d claimed tickets. Unclaim or close a ticket before claiming another.",
+	MessageTransferClaimLimitReached: "%s has reached the limit of %d claimed tickets, so the ticket cannot be transferred to them.",
+	MessageClaimLimitSetGuild:        "Staff members can now have at most %d tickets claimed at once.",
+	MessageClaimLimitSetTeam:         "Members of the `%s` team can now have at most %d tickets claimed at once.",
+	MessageClaimLimitRemoveGuild:     "The server-wide claim limit has been removed.",
+	MessageClaimLimitRemoveTeam:      "The claim limit for the `%s` team has been removed.",
+	MessageClaimLimitNotSet:          "There is no claim limit set.",
+	MessageClaimLimitInvalidTeam:     "Invalid support team provided.",
+	MessageClaimLimitInvalidNumber:   "The limit must be a whole number of at least 1.",
+
+	MessageFormValidationFailed:    "Some of your answers are invalid. Please fix them and submit the form again:\n%s",
+	MessageFormValidationRequired:  "An answer is required.",
+	MessageFormValidationTooShort:  "Must be at least %d characters long.",
+	MessageFormValidationTooLong:   "Must be at most %d characters long.",
+	MessageFormValidationPattern:   "Is not in the expected format.",
+	MessageFormValidationInteger:   "Must be a whole number.",
+	MessageFormValidationDecimal:   "Must be a number.",
+	MessageFormValidationTooSmall:  "Must be at least %s.",
+	MessageFormValidationTooLarge:  "Must be at most %s.",
+	MessageFormValidationEmail:     "Must be a valid email address.",
+	MessageFormValidationUrl:       "Must be a valid URL, starting with http:// or https://.",
+	MessageFormValidationSnowflake: "Must be a valid Discord ID.",
+
+	MessageAutoCloseWarning:              "This ticket will be automatically closed %s due to inactivity. Send a message or press the button below to keep it open.",
+	MessageAutoCloseWarningDm:            "Your ticket <#%d> will be automatically closed %s due to inactivity. Send a message in the ticket to keep it open.",
+	MessageAutoCloseKeepOpen:             "Keep Open",
+	MessageAutoCloseKeptOpen:             "<@%d> has kept this ticket open.",
+	MessageAutoCloseKeepOpenNoPermission: "Only the ticket opener and staff can keep this ticket open.",
+	MessageAutoCloseWarningSet:           "Tickets will now be warned %d minutes before they are automatically closed.",
+	MessageAutoCloseWarningDisabled:      "Tickets will now be automatically closed without a warning.",
+	MessageAutoCloseWarningInvalidPeriod: "The warning period must be a number of minutes, or 0 to disable the warning.",
+
+	MessageViewStaffClaimLoad:        "%d claimed",
+	MessageViewStaffClaimLoadLimited: "%d / %d claimed",
+
+	MessageTicketsListTitle:     "Tickets",
+	MessageTicketsListEmpty:     "No tickets match the filters.",
+	MessageTicketsListEntry:     "**#%d** %s - <@%d> - %s\n-# %s - %s - opened <t:%d:R> - last activity <t:%d:R>",
+	MessageTicketsListClaimedBy: "claimed by <@%d>",
+	MessageTicketsListUnclaimed: "unclaimed",
+	MessageTicketsListNoPanel:   "no panel",
+	MessageTicketsListCount:     "%d tickets",
+
+	MessageTicketInfoTitle:             "Ticket #%d",
+	MessageTicketInfoOpenedBy:          "**Opened by:** <@%d>",
+	MessageTicketInfoPanel:             "**Panel:** %s",
+	MessageTicketInfoOpenedAt:          "**Opened:** <t:%d:f> (<t:%d:R>)",
+	MessageTicketInfoStatus:            "**Status:** %s",
+	MessageTicketInfoClaimedBy:         "**Claimed by:** %s",
+	MessageTicketInfoLabels:            "**Labels:** %s",
+	MessageTicketInfoFirstResponse:     "**First response:** %s",
+	MessageTicketInfoAwaitingResponse:  "Awaiting response",
+	MessageTicketInfoParticipants:      "**Participants:** %s",
+	MessageTicketInfoCloseRequest:      "**Close request:** %s",
+	MessageTicketInfoCloseRequestAt:    "(closes <t:%d:R>)",
+	MessageTicketInfoAutoCloseExcluded: "**Excluded from autoclose:** %s",
+	MessageTicketInfoFormAnswers:       "Form Answers",
+	MessageTicketInfoNone:              "None",
+	MessageTicketInfoYes:               "Yes",
+	MessageTicketInfoNo:                "No",
+
+	MessageAutoAssigned:                "This ticket has been automatically assigned to %s.",
+	MessageAutoAssignSetSuccess:        "Tickets opened from the `%s` panel will now be automatically assigned using the %s strategy.",
+	MessageAutoAssignInvalidStrategy:   "Invalid strategy provided.",
+	MessageAutoAssignDisableSuccess:    "Tickets opened from the `%s` panel will no longer be automatically assigned.",
+	MessageAutoAssignDisableNotEnabled: "Automatic assignment is not enabled for the `%s` panel.",
+
+	MessageEscalationNoResponse:      "This ticket was opened %s and has not had a response from staff yet.",
+	MessageEscalationSetSuccess:      "%s will now be pinged if a ticket has no staff response after %d minutes.",
+	MessageEscalationInvalidTarget:   "Invalid escalation target provided.",
+	MessageEscalationTeamRequired:    "You must provide a support team to ping.",
+	MessageEscalationUserRequired:    "You must provide a user to ping.",
+	MessageEscalationInvalidDuration: "Durations must be a number of minutes of at least 1.",
+	MessageEscalationDisableSuccess:  "Tickets will no longer be escalated when there is no staff response.",
+	MessageEscalationNotEnabled:      "Escalation is not enabled.",
+
+	MessageEscalateSuccess:           "<@%d> has escalated this ticket to the `%s` team (level %d).",
+	MessageEscalateSuccessWithReason: "<@%d> has escalated this ticket to the `%s` team (level %d) with reason: `%s`",
+	MessageEscalateInvalidTeam:       "Invalid support team provided.",
+	MessageEscalateAlreadyEscalated:  "This ticket has already been escalated to the `%s` team.",
+	MessageEscalateNoPermission:      "You do not have permission to escalate this ticket.",
+	MessageEscalateReasonTooLong:     "The reason must be 255 characters or fewer.",
+
+	MessageMergeSourceNotice:  "This ticket has been merged into ticket #%d (<#%d>) and will now be closed. [View transcript](%s)",
+	MessageMergeTargetNotice:  "Ticket #%d has been merged into this ticket. [View transcript](%s)",
+	MessageMergeSameTicket:    "A ticket cannot be merged into itself.",
+	MessageMergeInvalidTarget: "Invalid ticket provided. The ticket must be open.",
+	MessageMergeDifferentUser: "Only tickets opened by the same user can be merged.",
+	MessageMergeNoPermission:  "You do not have permission to merge these tickets.",
+
+	MessageScheduledClose:                 "<@%d> has scheduled this ticket to close %s.",
+	MessageScheduledCloseWithReason:       "<@%d> has scheduled this ticket to close %s with reason: `%s`",
+	MessageScheduledCloseInvalidDelay:     "Invalid delay provided. Use a duration such as `2h` or `1d`.",
+	MessageScheduledCloseCancel:           "Cancel",
+	MessageScheduledCloseCancelled:        "<@%d> has cancelled the scheduled close.",
+	MessageScheduledCloseCancelledByReply: "The scheduled close has been cancelled, as <@%d> replied.",
+	MessageScheduledCloseNoPermission:     "Only staff can cancel the scheduled close.",
+
+	MessageSnoozeSuccess:         "This ticket has been snoozed until %s (%s). Autoclose and SLA timers are paused until then.",
+	MessageSnoozeInvalidTime:     "Invalid time provided. Use a duration such as `3d` or a UTC date such as `2025-01-31 09:00`.",
+	MessageSnoozeNoPermission:    "You do not have permission to snooze this ticket.",
+	MessageSnoozeEnded:           "The snooze on this ticket ended %s.",
+	MessageSnoozeModalLabel:      "Snooze until",
+	MessageSnoozeModalHint:       "A duration such as 3d or 12h, or a UTC date such as 2025-01-31 09:00",
+	MessageUnsnoozeSuccess:       "This ticket is no longer snoozed.",
+	MessageUnsnoozeNotSnoozed:    "This ticket is not snoozed.",
+	MessageSnoozeSettingsSuccess: "The snooze settings have been updated.",
+	MessageSnoozeInvalidCategory: "Invalid category provided.",
+
+	MessagePrioritySuccess:         "<@%d> has set the priority of this ticket to **%s**.",
+	MessagePriorityInvalid:         "Invalid priority provided.",
+	MessagePrioritySettingsSuccess: "The priority settings have been updated.",
+	MessagePriorityInvalidPanel:    "Invalid panel provided.",
+
+	MessageFormValidationSetSuccess:          "Answers to `%s` must now be a valid %s.",
+	MessageFormValidationRemoveSuccess:       "Answers to `%s` are no longer validated.",
+	MessageFormValidationRemoveNotFound:      "`%s` does not have a validation rule.",
+	MessageFormValidationInvalidInput:        "Invalid form input provided.",
+	MessageFormValidationInvalidType:         "Invalid validation type provided.",
+	MessageFormValidationMissingPattern:      "You must provide a pattern for regex validation.",
+	MessageFormValidationInvalidPattern:      "Invalid pattern provided: `%s`",
+	MessageFormValidationErrorMessageTooLong: "The error message must be 255 characters or fewer.",
+	MessageFormValidationInvalidBounds:       "The minimum must not be greater than the maximum.",
+
+	MessageFormFlowBranchSuccess:   "After `%s`, users who answer `%s` with %s will now be shown `%s`.",
+	MessageFormFlowDefaultSuccess:  "After `%s`, users will now be shown `%s` if no branch matches.",
+	MessageFormFlowDefaultRemoved:  "Tickets will now be opened after `%s` if no branch matches.",
+	MessageFormFlowClearSuccess:    "The flow for `%s` has been cleared.",
+	MessageFormFlowClearNotFound:   "`%s` does not have a flow.",
+	MessageFormFlowInvalidForm:     "Invalid form provided.",
+	MessageFormFlowInvalidInput:    "Invalid input provided. The input must be on `%s`.",
+	MessageFormFlowSameForm:        "A form cannot lead to itself.",
+	MessageFormFlowTooManyBranches: "A form can have at most %d branches.",
+	MessageFormStepContinue:        "Your answers have been saved. Press the button below to continue to step %d.",
+	MessageFormStepContinueButton:  "Continue",
+	MessageFormStepExpired:         "This form has expired. Please open the ticket again.",
+
+	MessageRoutingAddSuccess:           "The routing rule `%s` has been added, in position %d.",
+	MessageRoutingRemoveSuccess:        "The routing rule `%s` has been removed.",
+	MessageRoutingRemoveNotFound:       "There is no routing rule named `%s`.",
+	MessageRoutingList:                 "Rules are checked in order, and the first that matches is used:\n%s",
+	MessageRoutingListDryRun:           "Rules are checked in order, and the first that matches is used:\n%s\n\nDry run is enabled, so matches are only reported in %s.",
+	MessageRoutingListEmpty:            "There are no routing rules.",
+	MessageRoutingTooManyRules:         "You can have at most %d routing rules.",
+	MessageRoutingDuplicateName:        "There is already a routing rule named `%s`.",
+	MessageRoutingMissingAnswers:       "You must provide both a question and the answers to match.",
+	MessageRoutingNoOverrides:          "You must provide at least one of a category, team, role to mention, naming scheme or label for matching tickets.",
+	MessageRoutingInvalidPanel:         "Invalid panel provided.",
+	MessageRoutingInvalidTimeRange:     "Invalid hours or timezone provided. Use a range such as `09:00-17:00` and a timezone such as `Europe/London`.",
+	MessageRoutingInvalidCategory:      "Invalid category provided.",
+	MessageRoutingInvalidTeam:          "Invalid support team provided.",
+	MessageRoutingInvalidLabel:         "Invalid label provided.",
+	MessageRoutingDryRunEnabled:        "Dry run has been enabled. Matched rules will be reported in %s instead of being applied.",
+	MessageRoutingDryRunDisabled:       "Dry run has been disabled. Matched rules will now be applied.",
+	MessageRoutingDryRunInvalidChannel: "Invalid channel provided.",
+	MessageRoutingDryRunMatched:        "Ticket #%d (%s) matched the routing rule `%s`.",
+	MessageRoutingDryRunNoMatch:        "Ticket #%d (%s) did not match any routing rules.",
+
+	MessageSlaWarningFirstResponse: "This ticket needs a first response from staff %s.",
+	MessageSlaWarningResolution:    "This ticket needs to be resolved %s.",
+	MessageSlaBreachFirstResponse:  "This ticket missed its first response target %s.",
+	MessageSlaBreachResolution:     "This ticket missed its resolution target %s.",
+	MessageSlaSetSuccess:           "The SLA targets for the `%s` panel have been updated.",
+	MessageSlaSetNoTargets:         "You must provide at least one target.",
+	MessageSlaInvalidPanel:         "Invalid panel provided.",
+	MessageSlaRemoveSuccess:        "The SLA targets for the `%s` panel have been removed.",
+	MessageSlaRemoveNotFound:       "The `%s` panel does not have any SLA targets.",
+
+	HelpAutoCloseWarning:             "Warn users before their ticket is automatically closed",
+	HelpTickets:                      "Commands for viewing tickets",
+	HelpTicketsList:                  "Lists tickets, with filters",
+	HelpTicket:                       "Commands for the current ticket",
+	HelpTicketInfo:                   "Shows a summary of the current ticket",
+	HelpAutoAssign:                   "Automatically assign new tickets to staff",
+	HelpAutoAssignSet:                "Automatically assign tickets opened from a panel",
+	HelpAutoAssignDisable:            "Stop automatically assigning tickets opened from a panel",
+	HelpClaimLimit:                   "Limit how many tickets staff can claim at once",
+	HelpClaimLimitSet:                "Sets the maximum number of tickets staff can claim at once",
+	HelpClaimLimitRemove:             "Removes a claim limit",
+	HelpEscalation:                   "Ping someone when a ticket has no staff response",
+	HelpEscalationSet:                "Sets who to ping when a ticket has no staff response",
+	HelpEscalationDisable:            "Stops pinging when a ticket has no staff response",
+	HelpSla:                          "Set response and resolution targets for panels",
+	HelpSnooze:                       "Pauses autoclose and SLA timers until a set time",
+	HelpMerge:                        "Closes this ticket into another ticket from the same user",
+	HelpEscalate:                     "Hands the ticket over to another support team",
+	HelpUnsnooze:                     "Ends the snooze on the current ticket",
+	HelpSnoozeSettings:               "Configures snoozing tickets",
+	HelpPriority:                     "Sets the priority of the current ticket",
+	HelpPrioritySettings:             "Configures ticket priorities",
+	HelpSlaSet:                       "Sets the SLA targets for a panel",
+	HelpSlaRemove:                    "Removes the SLA targets from a panel",
+	HelpPrioritySettingsGeneral:      "Configures how priorities affect tickets",
+	HelpPrioritySettingsPanelDefault: "Sets the priority that tickets opened from a panel start with",
+	HelpFormValidation:               "Validate the answers given to forms",
+	HelpFormValidationSet:            "Sets the validation rule for a form input",
+	HelpFormValidationRemove:         "Removes the validation rule from a form input",
+	HelpFormFlow:                     "Show further forms based on previous answers",
+	HelpFormFlowBranch:               "Shows another form when an input has a given answer",
+	HelpFormFlowDefault:              "Sets the form to show when no branch matches",
+	HelpFormFlowClear:                "Removes the flow from a form",
+	HelpRouting:                      "Route new tickets based on their answers, roles, locale and time",
+	HelpRoutingAdd:                   "Adds a routing rule",
+	HelpRoutingRemove:                "Removes a routing rule",
+	HelpRoutingList:                  "Lists the routing rules",
+	HelpRoutingDryRun:                "Reports matched rules in a channel instead of applying them",
+
+	MessageEditPriorityTitle:       "Priority",
+	MessageEditPriorityDescription: "Change the priority of this ticket",
+}
+
+// applyEnglishDefaults adds the default for every message that the locale file does not contain
+func applyEnglishDefaults(messages map[MessageId]string) {
+	for id, value := range englishDefaults {
+		if _, ok := messages[id]; !ok {
+			messages[id] = value
+		}
+	}
+}
@@ -33,6 +33,10 @@ func LoadMessages() {
 			continue
 		}
 
+		if locale.IsoLongCode == "en-GB" {
+			applyEnglishDefaults(messages)
+		}
+
 		Locales[idx].Messages = messages
 	}
 }
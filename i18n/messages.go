@@ -52,6 +52,18 @@ var (
 	TitlePanelSwitched     MessageId = "generic.title.panel_switched"
 	TitleJumpToTop         MessageId = "generic.title.jump_to_top"
 	TitleReopened          MessageId = "generic.title.reopened"
+	TitleSlaWarning        MessageId = "generic.title.sla_warning"
+	TitleSlaBreach         MessageId = "generic.title.sla_breach"
+	TitleSla               MessageId = "generic.title.sla"
+	TitleAutoAssign        MessageId = "generic.title.autoassign"
+	TitleClaimLimit        MessageId = "generic.title.claimlimit"
+	TitleEscalation        MessageId = "generic.title.escalation"
+	TitleSnooze            MessageId = "generic.title.snooze"
+	TitleMerge             MessageId = "generic.title.merge"
+	TitlePriority          MessageId = "generic.title.priority"
+	TitleFormValidation    MessageId = "generic.title.form_validation"
+	TitleFormFlow          MessageId = "generic.title.form_flow"
+	TitleRouting           MessageId = "generic.title.routing"
 
 	MessageAbout   MessageId = "commands.about"
 	MessagePremium MessageId = "commands.premium"
@@ -134,6 +146,17 @@ var (
 	MessageClaimed           MessageId = "commands.claim.success"
 	MessageClaimNoPermission MessageId = "commands.claim.no_permission"
 	MessageClaimThread       MessageId = "commands.claim.thread"
+	MessageClaimLimitReached MessageId = "commands.claim.limit_reached"
+
+	MessageTransferClaimLimitReached MessageId = "commands.transfer.limit_reached"
+
+	MessageClaimLimitSetGuild      MessageId = "commands.claimlimit.set.guild"
+	MessageClaimLimitSetTeam       MessageId = "commands.claimlimit.set.team"
+	MessageClaimLimitRemoveGuild   MessageId = "commands.claimlimit.remove.guild"
+	MessageClaimLimitRemoveTeam    MessageId = "commands.claimlimit.remove.team"
+	MessageClaimLimitNotSet        MessageId = "commands.claimlimit.not_set"
+	MessageClaimLimitInvalidTeam   MessageId = "commands.claimlimit.invalid_team"
+	MessageClaimLimitInvalidNumber MessageId = "commands.claimlimit.invalid_number"
 
 	MessagePanel MessageId = "commands.panel"
 
@@ -198,6 +221,20 @@ var (
 	MessageTicketStartedFrom        MessageId = "commands.open.from"
 	MessageMovedToTicket            MessageId = "commands.open.from.moved"
 	MessageFormMissingInput         MessageId = "commands.open.missing_form_answer"
+
+	MessageFormValidationFailed    MessageId = "commands.open.form_validation.failed"
+	MessageFormValidationRequired  MessageId = "commands.open.form_validation.required"
+	MessageFormValidationTooShort  MessageId = "commands.open.form_validation.too_short"
+	MessageFormValidationTooLong   MessageId = "commands.open.form_validation.too_long"
+	MessageFormValidationPattern   MessageId = "commands.open.form_validation.pattern"
+	MessageFormValidationInteger   MessageId = "commands.open.form_validation.integer"
+	MessageFormValidationDecimal   MessageId = "commands.open.form_validation.decimal"
+	MessageFormValidationTooSmall  MessageId = "commands.open.form_validation.too_small"
+	MessageFormValidationTooLarge  MessageId = "commands.open.form_validation.too_large"
+	MessageFormValidationEmail     MessageId = "commands.open.form_validation.email"
+	MessageFormValidationUrl       MessageId = "commands.open.form_validation.url"
+	MessageFormValidationSnowflake MessageId = "commands.open.form_validation.snowflake"
+
 	MessageOpenCommandDisabled      MessageId = "commands.open.disabled"
 	MessageOpenCantSeeParentChannel MessageId = "commands.open.threads.cant_see_parent_channel"
 	MessageOpenCantMessageInThreads MessageId = "commands.open.threads.cant_message_in_threads"
@@ -220,6 +257,15 @@ var (
 	MessageAutoCloseConfigure MessageId = "commands.autoclose.configure"
 	MessageAutoCloseExclude   MessageId = "commands.autoclose.exclude.success"
 
+	MessageAutoCloseWarning              MessageId = "autoclose.warning"
+	MessageAutoCloseWarningDm            MessageId = "autoclose.warning_dm"
+	MessageAutoCloseKeepOpen             MessageId = "autoclose.keep_open"
+	MessageAutoCloseKeptOpen             MessageId = "autoclose.kept_open"
+	MessageAutoCloseKeepOpenNoPermission MessageId = "autoclose.keep_open.no_permission"
+	MessageAutoCloseWarningSet           MessageId = "commands.autoclose.warning.success"
+	MessageAutoCloseWarningDisabled      MessageId = "commands.autoclose.warning.disabled"
+	MessageAutoCloseWarningInvalidPeriod MessageId = "commands.autoclose.warning.invalid_period"
+
 	MessageJumpToTopNoWelcomeMessage MessageId = "commands.jump_to_top.no_welcome_message"
 	MessageJumpToTopContent          MessageId = "commands.jump_to_top.content"
 
@@ -254,6 +300,142 @@ var (
 	MessageViewStaffSupportUsersWarn MessageId = "commands.viewstaff.support.users_warning"
 	MessageViewStaffSupportRoles     MessageId = "commands.viewstaff.support.roles"
 	MessageViewStaffNoSupportRoles   MessageId = "commands.viewstaff.support.no_roles"
+	MessageViewStaffClaimLoad        MessageId = "commands.viewstaff.claim_load"
+	MessageViewStaffClaimLoadLimited MessageId = "commands.viewstaff.claim_load_limited"
+
+	MessageTicketsListTitle     MessageId = "commands.tickets.list.title"
+	MessageTicketsListEmpty     MessageId = "commands.tickets.list.empty"
+	MessageTicketsListEntry     MessageId = "commands.tickets.list.entry"
+	MessageTicketsListClaimedBy MessageId = "commands.tickets.list.claimed_by"
+	MessageTicketsListUnclaimed MessageId = "commands.tickets.list.unclaimed"
+	MessageTicketsListNoPanel   MessageId = "commands.tickets.list.no_panel"
+	MessageTicketsListCount     MessageId = "commands.tickets.list.count"
+
+	MessageTicketInfoTitle             MessageId = "commands.ticket.info.title"
+	MessageTicketInfoOpenedBy          MessageId = "commands.ticket.info.opened_by"
+	MessageTicketInfoPanel             MessageId = "commands.ticket.info.panel"
+	MessageTicketInfoOpenedAt          MessageId = "commands.ticket.info.opened_at"
+	MessageTicketInfoStatus            MessageId = "commands.ticket.info.status"
+	MessageTicketInfoClaimedBy         MessageId = "commands.ticket.info.claimed_by"
+	MessageTicketInfoLabels            MessageId = "commands.ticket.info.labels"
+	MessageTicketInfoFirstResponse     MessageId = "commands.ticket.info.first_response"
+	MessageTicketInfoAwaitingResponse  MessageId = "commands.ticket.info.awaiting_response"
+	MessageTicketInfoParticipants      MessageId = "commands.ticket.info.participants"
+	MessageTicketInfoCloseRequest      MessageId = "commands.ticket.info.close_request"
+	MessageTicketInfoCloseRequestAt    MessageId = "commands.ticket.info.close_request_at"
+	MessageTicketInfoAutoCloseExcluded MessageId = "commands.ticket.info.autoclose_excluded"
+	MessageTicketInfoFormAnswers       MessageId = "commands.ticket.info.form_answers"
+	MessageTicketInfoNone              MessageId = "commands.ticket.info.none"
+	MessageTicketInfoYes               MessageId = "commands.ticket.info.yes"
+	MessageTicketInfoNo                MessageId = "commands.ticket.info.no"
+
+	MessageAutoAssigned                MessageId = "commands.autoassign.assigned"
+	MessageAutoAssignSetSuccess        MessageId = "commands.autoassign.set.success"
+	MessageAutoAssignInvalidStrategy   MessageId = "commands.autoassign.set.invalid_strategy"
+	MessageAutoAssignDisableSuccess    MessageId = "commands.autoassign.disable.success"
+	MessageAutoAssignDisableNotEnabled MessageId = "commands.autoassign.disable.not_enabled"
+
+	MessageEscalationNoResponse      MessageId = "escalation.no_response"
+	MessageEscalationSetSuccess      MessageId = "commands.escalation.set.success"
+	MessageEscalationInvalidTarget   MessageId = "commands.escalation.set.invalid_target"
+	MessageEscalationTeamRequired    MessageId = "commands.escalation.set.team_required"
+	MessageEscalationUserRequired    MessageId = "commands.escalation.set.user_required"
+	MessageEscalationInvalidDuration MessageId = "commands.escalation.set.invalid_duration"
+	MessageEscalationDisableSuccess  MessageId = "commands.escalation.disable.success"
+	MessageEscalationNotEnabled      MessageId = "commands.escalation.disable.not_enabled"
+
+	MessageEscalateSuccess           MessageId = "commands.escalate.success"
+	MessageEscalateSuccessWithReason MessageId = "commands.escalate.success_with_reason"
+	MessageEscalateInvalidTeam       MessageId = "commands.escalate.invalid_team"
+	MessageEscalateAlreadyEscalated  MessageId = "commands.escalate.already_escalated"
+	MessageEscalateNoPermission      MessageId = "commands.escalate.no_permission"
+	MessageEscalateReasonTooLong     MessageId = "commands.escalate.reason_too_long"
+
+	MessageMergeSourceNotice  MessageId = "commands.merge.source_notice"
+	MessageMergeTargetNotice  MessageId = "commands.merge.target_notice"
+	MessageMergeSameTicket    MessageId = "commands.merge.same_ticket"
+	MessageMergeInvalidTarget MessageId = "commands.merge.invalid_target"
+	MessageMergeDifferentUser MessageId = "commands.merge.different_user"
+	MessageMergeNoPermission  MessageId = "commands.merge.no_permission"
+
+	MessageScheduledClose                 MessageId = "commands.close.scheduled"
+	MessageScheduledCloseWithReason       MessageId = "commands.close.scheduled_with_reason"
+	MessageScheduledCloseInvalidDelay     MessageId = "commands.close.invalid_delay"
+	MessageScheduledCloseCancel           MessageId = "commands.close.cancel"
+	MessageScheduledCloseCancelled        MessageId = "commands.close.cancelled"
+	MessageScheduledCloseCancelledByReply MessageId = "commands.close.cancelled_by_reply"
+	MessageScheduledCloseNoPermission     MessageId = "commands.close.cancel_no_permission"
+
+	MessageSnoozeSuccess         MessageId = "commands.snooze.success"
+	MessageSnoozeInvalidTime     MessageId = "commands.snooze.invalid_time"
+	MessageSnoozeNoPermission    MessageId = "commands.snooze.no_permission"
+	MessageSnoozeEnded           MessageId = "snooze.ended"
+	MessageSnoozeModalLabel      MessageId = "snooze.modal.label"
+	MessageSnoozeModalHint       MessageId = "snooze.modal.hint"
+	MessageUnsnoozeSuccess       MessageId = "commands.unsnooze.success"
+	MessageUnsnoozeNotSnoozed    MessageId = "commands.unsnooze.not_snoozed"
+	MessageSnoozeSettingsSuccess MessageId = "commands.snoozesettings.success"
+	MessageSnoozeInvalidCategory MessageId = "commands.snoozesettings.invalid_category"
+
+	MessagePrioritySuccess         MessageId = "commands.priority.success"
+	MessagePriorityInvalid         MessageId = "commands.priority.invalid"
+	MessagePrioritySettingsSuccess MessageId = "commands.prioritysettings.success"
+	MessagePriorityInvalidPanel    MessageId = "commands.prioritysettings.invalid_panel"
+
+	MessageFormValidationSetSuccess          MessageId = "commands.formvalidation.set.success"
+	MessageFormValidationRemoveSuccess       MessageId = "commands.formvalidation.remove.success"
+	MessageFormValidationRemoveNotFound      MessageId = "commands.formvalidation.remove.not_found"
+	MessageFormValidationInvalidInput        MessageId = "commands.formvalidation.invalid_input"
+	MessageFormValidationInvalidType         MessageId = "commands.formvalidation.invalid_type"
+	MessageFormValidationMissingPattern      MessageId = "commands.formvalidation.missing_pattern"
+	MessageFormValidationInvalidPattern      MessageId = "commands.formvalidation.invalid_pattern"
+	MessageFormValidationErrorMessageTooLong MessageId = "commands.formvalidation.error_message_too_long"
+	MessageFormValidationInvalidBounds       MessageId = "commands.formvalidation.invalid_bounds"
+
+	MessageFormFlowBranchSuccess   MessageId = "commands.formflow.branch.success"
+	MessageFormFlowDefaultSuccess  MessageId = "commands.formflow.default.success"
+	MessageFormFlowDefaultRemoved  MessageId = "commands.formflow.default.removed"
+	MessageFormFlowClearSuccess    MessageId = "commands.formflow.clear.success"
+	MessageFormFlowClearNotFound   MessageId = "commands.formflow.clear.not_found"
+	MessageFormFlowInvalidForm     MessageId = "commands.formflow.invalid_form"
+	MessageFormFlowInvalidInput    MessageId = "commands.formflow.invalid_input"
+	MessageFormFlowSameForm        MessageId = "commands.formflow.same_form"
+	MessageFormFlowTooManyBranches MessageId = "commands.formflow.too_many_branches"
+	MessageFormStepContinue        MessageId = "commands.open.form_step.continue"
+	MessageFormStepContinueButton  MessageId = "commands.open.form_step.continue_button"
+	MessageFormStepExpired         MessageId = "commands.open.form_step.expired"
+
+	MessageRoutingAddSuccess           MessageId = "commands.routing.add.success"
+	MessageRoutingRemoveSuccess        MessageId = "commands.routing.remove.success"
+	MessageRoutingRemoveNotFound       MessageId = "commands.routing.remove.not_found"
+	MessageRoutingList                 MessageId = "commands.routing.list"
+	MessageRoutingListDryRun           MessageId = "commands.routing.list.dry_run"
+	MessageRoutingListEmpty            MessageId = "commands.routing.list.empty"
+	MessageRoutingTooManyRules         MessageId = "commands.routing.too_many_rules"
+	MessageRoutingDuplicateName        MessageId = "commands.routing.duplicate_name"
+	MessageRoutingMissingAnswers       MessageId = "commands.routing.missing_answers"
+	MessageRoutingNoOverrides          MessageId = "commands.routing.no_overrides"
+	MessageRoutingInvalidPanel         MessageId = "commands.routing.invalid_panel"
+	MessageRoutingInvalidTimeRange     MessageId = "commands.routing.invalid_time_range"
+	MessageRoutingInvalidCategory      MessageId = "commands.routing.invalid_category"
+	MessageRoutingInvalidTeam          MessageId = "commands.routing.invalid_team"
+	MessageRoutingInvalidLabel         MessageId = "commands.routing.invalid_label"
+	MessageRoutingDryRunEnabled        MessageId = "commands.routing.dryrun.enabled"
+	MessageRoutingDryRunDisabled       MessageId = "commands.routing.dryrun.disabled"
+	MessageRoutingDryRunInvalidChannel MessageId = "commands.routing.dryrun.invalid_channel"
+	MessageRoutingDryRunMatched        MessageId = "routing.dry_run.matched"
+	MessageRoutingDryRunNoMatch        MessageId = "routing.dry_run.no_match"
+
+	MessageSlaWarningFirstResponse MessageId = "sla.warning.first_response"
+	MessageSlaWarningResolution    MessageId = "sla.warning.resolution"
+	MessageSlaBreachFirstResponse  MessageId = "sla.breach.first_response"
+	MessageSlaBreachResolution     MessageId = "sla.breach.resolution"
+
+	MessageSlaSetSuccess     MessageId = "commands.sla.set.success"
+	MessageSlaSetNoTargets   MessageId = "commands.sla.set.no_targets"
+	MessageSlaInvalidPanel   MessageId = "commands.sla.invalid_panel"
+	MessageSlaRemoveSuccess  MessageId = "commands.sla.remove.success"
+	MessageSlaRemoveNotFound MessageId = "commands.sla.remove.not_found"
 
 	MessageJoinClosedTicket       MessageId = "button.join_thread.closed_ticket"
 	MessageJoinThreadNoPermission MessageId = "button.join_thread.no_permission"
@@ -331,6 +513,7 @@ var (
 	HelpAutoClose          MessageId = "help.autoclose"
 	HelpAutoCloseExclude   MessageId = "help.autoclose.exclude"
 	HelpAutoCloseConfigure MessageId = "help.autoclose.configure"
+	HelpAutoCloseWarning   MessageId = "help.autoclose.warning"
 	HelpVote               MessageId = "help.vote"
 	HelpAddAdmin           MessageId = "help.addadmin"
 	HelpAddSupport         MessageId = "help.addsupport"
@@ -366,6 +549,44 @@ var (
 	HelpOnCall             MessageId = "help.on_call"
 	HelpGdpr               MessageId = "help.gdpr"
 	HelpEdit               MessageId = "help.edit"
+	HelpTickets            MessageId = "help.tickets"
+	HelpTicketsList        MessageId = "help.tickets.list"
+	HelpTicket             MessageId = "help.ticket"
+	HelpTicketInfo         MessageId = "help.ticket.info"
+	HelpAutoAssign         MessageId = "help.autoassign"
+	HelpAutoAssignSet      MessageId = "help.autoassign.set"
+	HelpAutoAssignDisable  MessageId = "help.autoassign.disable"
+	HelpClaimLimit         MessageId = "help.claimlimit"
+	HelpClaimLimitSet      MessageId = "help.claimlimit.set"
+	HelpClaimLimitRemove   MessageId = "help.claimlimit.remove"
+	HelpEscalation         MessageId = "help.escalation"
+	HelpEscalationSet      MessageId = "help.escalation.set"
+	HelpEscalationDisable  MessageId = "help.escalation.disable"
+	HelpSla                MessageId = "help.sla"
+	HelpSnooze             MessageId = "help.snooze"
+	HelpMerge              MessageId = "help.merge"
+	HelpEscalate           MessageId = "help.escalate"
+	HelpUnsnooze           MessageId = "help.unsnooze"
+	HelpSnoozeSettings     MessageId = "help.snoozesettings"
+	HelpPriority           MessageId = "help.priority"
+	HelpPrioritySettings   MessageId = "help.prioritysettings"
+	HelpSlaSet             MessageId = "help.sla.set"
+	HelpSlaRemove          MessageId = "help.sla.remove"
+
+	HelpPrioritySettingsGeneral      MessageId = "help.prioritysettings.general"
+	HelpPrioritySettingsPanelDefault MessageId = "help.prioritysettings.paneldefault"
+	HelpFormValidation               MessageId = "help.formvalidation"
+	HelpFormValidationSet            MessageId = "help.formvalidation.set"
+	HelpFormValidationRemove         MessageId = "help.formvalidation.remove"
+	HelpFormFlow                     MessageId = "help.formflow"
+	HelpFormFlowBranch               MessageId = "help.formflow.branch"
+	HelpFormFlowDefault              MessageId = "help.formflow.default"
+	HelpFormFlowClear                MessageId = "help.formflow.clear"
+	HelpRouting                      MessageId = "help.routing"
+	HelpRoutingAdd                   MessageId = "help.routing.add"
+	HelpRoutingRemove                MessageId = "help.routing.remove"
+	HelpRoutingList                  MessageId = "help.routing.list"
+	HelpRoutingDryRun                MessageId = "help.routing.dryrun"
 
 	GdprIntro                     MessageId = "gdpr.intro"
 	GdprTranscriptSectionTitle    MessageId = "gdpr.section.transcript"
@@ -426,4 +647,6 @@ var (
 	MessageEditLabelsModalSelectMenuTitle MessageId = "commands.edit.labels.update_modal.select_menu_title"
 	MessageEditLabelsModalSuccess         MessageId = "commands.edit.labels.update_modal.success"
 	MessageEditLabelsNoneConfigured       MessageId = "commands.edit.labels.none_configured"
+	MessageEditPriorityTitle              MessageId = "commands.edit.priority.title"
+	MessageEditPriorityDescription        MessageId = "commands.edit.priority.description"
 )